@@ -1,27 +1,76 @@
 package BloomFilter
 
 import (
-	"hash/fnv"
-	"reflect"
-	"strconv"
+	"errors"
+	"math"
+	"math/bits"
 	"sync"
+
+	"github.com/AustinMusiku/dataStructures/Hashing"
 )
 
-type Hashable interface {
-	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr | ~float32 | ~float64 | ~string
+type Hashable = Hashing.Hashable
+
+// Hasher turns a value into a 64-bit hash.
+// Hashes should be spread evenly over all 64 bits since they are split in two
+// to derive the location of every bit
+type Hasher[T any] interface {
+	Sum64(value T) uint64
+}
+
+// HasherFunc adapts an ordinary function to the Hasher interface
+type HasherFunc[T any] func(value T) uint64
+
+func (f HasherFunc[T]) Sum64(value T) uint64 {
+	return f(value)
 }
 
-type BloomFilter[T Hashable] struct {
+type BloomFilter[T any] struct {
 	mu     sync.RWMutex
+	hasher Hasher[T]
 	bitmap []byte
+	size   uint64 // number of bits in the bitmap
+	hashes uint   // number of bits set per element
 	count  int
 }
 
-// Create a new Bloom Filter
+// Create a new Bloom Filter with the given number of bits.
+// Every element sets a single bit in the filter
 func NewBloomFilter[T Hashable](size int) *BloomFilter[T] {
-	byteLen := (size + 7) / 8
+	if size < 1 {
+		size = 1
+	}
+
+	return newBloomFilter[T](Hashing.NewHasher[T](0), uint64(size), 1)
+}
+
+// Create a new Bloom Filter sized for the expected number of elements and
+// the target false-positive probability. The number of bits and hash
+// functions are derived from the two values
+func NewBloomFilterWithRate[T Hashable](elements uint, falsePositiveRate float64) (*BloomFilter[T], error) {
+	return NewBloomFilterWithHasher[T](elements, falsePositiveRate, Hashing.NewHasher[T](0))
+}
+
+// Create a new Bloom Filter for any type of value, hashed by the given hasher.
+// Sized the same way as NewBloomFilterWithRate
+func NewBloomFilterWithHasher[T any](elements uint, falsePositiveRate float64, hasher Hasher[T]) (*BloomFilter[T], error) {
+	if err := validateEstimates(elements, falsePositiveRate); err != nil {
+		return nil, err
+	}
+	if hasher == nil {
+		return nil, errors.New("hasher must not be nil")
+	}
+
+	size := optimalSize(elements, falsePositiveRate)
+	return newBloomFilter(hasher, size, optimalHashes(size, elements)), nil
+}
+
+func newBloomFilter[T any](hasher Hasher[T], size uint64, hashes uint) *BloomFilter[T] {
 	return &BloomFilter[T]{
-		bitmap: make([]byte, byteLen),
+		hasher: hasher,
+		bitmap: make([]byte, (size+7)/8),
+		size:   size,
+		hashes: hashes,
 		count:  0,
 	}
 }
@@ -31,14 +80,17 @@ func (b *BloomFilter[T]) Add(value T) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	hash := b.hash(value)
+	h1, h2 := split(b.hasher.Sum64(value))
 
-	// Locate the bit to switch on
-	byteIdx, bitIdx := hash/8, hash%8
+	for i := uint(0); i < b.hashes; i++ {
+		// Locate the bit to switch on
+		location := nthLocation(h1, h2, i, b.size)
+		byteIdx, bitIdx := location/8, location%8
 
-	// left shift the binary equivalent of 1 by bitIdx positions and
-	// perfom a bitwise OR with the byte at index [byteIdx]
-	b.bitmap[byteIdx] |= 1 << bitIdx
+		// left shift the binary equivalent of 1 by bitIdx positions and
+		// perfom a bitwise OR with the byte at index [byteIdx]
+		b.bitmap[byteIdx] |= 1 << bitIdx
+	}
 
 	b.count++
 }
@@ -48,14 +100,21 @@ func (b *BloomFilter[T]) Check(value T) bool {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 
-	hash := b.hash(value)
+	h1, h2 := split(b.hasher.Sum64(value))
 
-	targetByte := b.bitmap[hash/8]
+	for i := uint(0); i < b.hashes; i++ {
+		location := nthLocation(h1, h2, i, b.size)
+		targetByte := b.bitmap[location/8]
+
+		// left shift the binary equivalent of 1 by bitIdx positions and
+		// perfom a bitwise AND with the targetByte.
+		// If the result is 0, the bit is not set and the value was never added
+		if targetByte&(1<<(location%8)) == 0 {
+			return false
+		}
+	}
 
-	// left shift the binary equivalent of 1 by bitIdx positions and
-	// perfom a bitwise AND with the targetByte.
-	// If the result is equal 1*2^bitIdx, then the bit is set
-	return targetByte&(1<<(hash%8)) == 1<<(hash%8)
+	return true
 }
 
 // Clear the filter and reset the count
@@ -68,33 +127,106 @@ func (b *BloomFilter[T]) Reset() {
 	b.count = 0
 }
 
-func (b *BloomFilter[T]) hash(value T) uint64 {
-	hashFunc := fnv.New64a()
+// Get the number of bits in the filter
+func (b *BloomFilter[T]) Size() uint64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.size
+}
 
-	var data string
+// Get the number of bits set for every element
+func (b *BloomFilter[T]) Hashes() uint {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
 
-	switch reflect.TypeOf(value).Kind() {
-	// integers
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		iValue := reflect.ValueOf(value).Int()
-		data = strconv.FormatInt(iValue, 10)
+	return b.hashes
+}
 
-	// unsigned integers
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		uValue := reflect.ValueOf(value).Uint()
-		data = strconv.FormatUint(uValue, 10)
+// Get the number of values added to the filter, including duplicates
+func (b *BloomFilter[T]) Count() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
 
-	// floats
-	case reflect.Float32, reflect.Float64:
-		floatValue := reflect.ValueOf(value).Float()
-		data = strconv.FormatFloat(floatValue, 'f', -1, 64)
+	return b.count
+}
 
-	// strings
-	case reflect.String:
-		data = reflect.ValueOf(value).String()
+// Estimate the number of distinct values in the filter from the number of set bits
+// using the Swamidass-Baldi formula: n = -(m/k) * ln(1 - X/m)
+func (b *BloomFilter[T]) EstimatedCount() float64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	setBits := float64(b.setBits())
+	m, k := float64(b.size), float64(b.hashes)
+
+	// every bit is set, the filter is saturated
+	if setBits >= m {
+		return math.Inf(1)
+	}
+
+	return -(m / k) * math.Log(1-setBits/m)
+}
+
+// Get the fraction of bits that are set
+func (b *BloomFilter[T]) FillRatio() float64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return float64(b.setBits()) / float64(b.size)
+}
+
+// Estimate the probability that Check returns true for a value that was never added.
+// This is the chance that all k bits of a value are set: (X/m)^k
+func (b *BloomFilter[T]) FalsePositiveRate() float64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return math.Pow(float64(b.setBits())/float64(b.size), float64(b.hashes))
+}
+
+// Returns the number of bits set in the bitmap
+func (b *BloomFilter[T]) setBits() uint64 {
+	var total uint64
+	for _, value := range b.bitmap {
+		total += uint64(bits.OnesCount8(value))
+	}
+	return total
+}
+
+// Returns the position of the i-th bit for a value using double hashing:
+// g(i) = h1 + i*h2 mod m
+func nthLocation(h1, h2 uint64, i uint, size uint64) uint64 {
+	return (h1 + uint64(i)*h2) % size
+}
+
+// Splits the hash of a value into the two hashes used for double hashing
+func split(sum uint64) (uint64, uint64) {
+	// keep h2 odd so that successive locations never collapse onto h1
+	return sum & math.MaxUint32, (sum >> 32) | 1
+}
+
+// Returns an error if a filter can't be sized from the given estimates
+func validateEstimates(elements uint, falsePositiveRate float64) error {
+	if elements == 0 {
+		return errors.New("expected number of elements must be greater than 0")
 	}
+	if !(falsePositiveRate > 0 && falsePositiveRate < 1) {
+		return errors.New("false positive rate must be between 0 and 1")
+	}
+	return nil
+}
 
-	hashFunc.Write([]byte(data))
+// Optimal number of bits for n elements at false-positive rate p:
+// m = -n*ln(p) / ln(2)^2
+func optimalSize(n uint, p float64) uint64 {
+	m := math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+	return uint64(math.Max(m, 1))
+}
 
-	return hashFunc.Sum64() % uint64(len(b.bitmap))
+// Optimal number of hash functions for m bits and n elements:
+// k = (m/n) * ln(2)
+func optimalHashes(m uint64, n uint) uint {
+	k := math.Round(float64(m) / float64(n) * math.Ln2)
+	return uint(math.Max(k, 1))
 }
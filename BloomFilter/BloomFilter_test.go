@@ -1,6 +1,12 @@
 package BloomFilter
 
-import "testing"
+import (
+	"hash/fnv"
+	"math"
+	"testing"
+
+	"github.com/AustinMusiku/dataStructures/Hashing"
+)
 
 func TestBloomFilter(t *testing.T) {
 	t.Parallel()
@@ -34,3 +40,198 @@ func TestBloomFilter(t *testing.T) {
 		}
 	}
 }
+
+func TestBloomFilterWithRate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Optimal parameters", func(t *testing.T) {
+		t.Parallel()
+
+		bf, err := NewBloomFilterWithRate[int](1000, 0.01)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		// m = -1000*ln(0.01)/ln(2)^2 ~ 9586, k = m/n*ln(2) ~ 7
+		if bf.Size() != 9586 {
+			t.Errorf("Expected size to be 9586, got %d", bf.Size())
+		}
+		if bf.Hashes() != 7 {
+			t.Errorf("Expected 7 hash functions, got %d", bf.Hashes())
+		}
+	})
+
+	t.Run("Invalid parameters", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := NewBloomFilterWithRate[int](0, 0.01); err == nil {
+			t.Error("Expected an error for 0 elements, got nil")
+		}
+		if _, err := NewBloomFilterWithRate[int](10, 0); err == nil {
+			t.Error("Expected an error for a rate of 0, got nil")
+		}
+		if _, err := NewBloomFilterWithRate[int](10, 1); err == nil {
+			t.Error("Expected an error for a rate of 1, got nil")
+		}
+	})
+
+	t.Run("False positive rate", func(t *testing.T) {
+		t.Parallel()
+
+		elements, rate := 10000, 0.01
+		bf, _ := NewBloomFilterWithRate[int](uint(elements), rate)
+
+		for i := 0; i < elements; i++ {
+			bf.Add(i)
+		}
+
+		for i := 0; i < elements; i++ {
+			if !bf.Check(i) {
+				t.Fatalf("Expected %d to be in the filter, got false", i)
+			}
+		}
+
+		falsePositives := 0
+		for i := elements; i < elements*2; i++ {
+			if bf.Check(i) {
+				falsePositives++
+			}
+		}
+
+		// allow some headroom over the target rate
+		if observed := float64(falsePositives) / float64(elements); observed > rate*2 {
+			t.Errorf("Expected false positive rate close to %f, got %f", rate, observed)
+		}
+	})
+}
+
+func TestBloomFilterEstimates(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Empty filter", func(t *testing.T) {
+		t.Parallel()
+
+		bf, _ := NewBloomFilterWithRate[int](1000, 0.01)
+
+		if bf.EstimatedCount() != 0 {
+			t.Errorf("Expected estimated count to be 0, got %f", bf.EstimatedCount())
+		}
+		if bf.FillRatio() != 0 {
+			t.Errorf("Expected fill ratio to be 0, got %f", bf.FillRatio())
+		}
+		if bf.FalsePositiveRate() != 0 {
+			t.Errorf("Expected false positive rate to be 0, got %f", bf.FalsePositiveRate())
+		}
+	})
+
+	t.Run("Populated filter", func(t *testing.T) {
+		t.Parallel()
+
+		elements, rate := 1000, 0.01
+		bf, _ := NewBloomFilterWithRate[int](uint(elements), rate)
+
+		// add every value twice, duplicates must not be estimated
+		for i := 0; i < elements; i++ {
+			bf.Add(i)
+			bf.Add(i)
+		}
+
+		if bf.Count() != elements*2 {
+			t.Errorf("Expected count to be %d, got %d", elements*2, bf.Count())
+		}
+
+		if estimate := bf.EstimatedCount(); math.Abs(estimate-float64(elements)) > float64(elements)*0.05 {
+			t.Errorf("Expected estimated count close to %d, got %f", elements, estimate)
+		}
+
+		// an optimally filled filter has about half its bits set
+		if ratio := bf.FillRatio(); math.Abs(ratio-0.5) > 0.05 {
+			t.Errorf("Expected fill ratio close to 0.5, got %f", ratio)
+		}
+
+		if fpr := bf.FalsePositiveRate(); math.Abs(fpr-rate) > rate*0.5 {
+			t.Errorf("Expected false positive rate close to %f, got %f", rate, fpr)
+		}
+	})
+
+	t.Run("Saturated filter", func(t *testing.T) {
+		t.Parallel()
+
+		bf := NewBloomFilter[int](8)
+		for i := 0; i < 1000; i++ {
+			bf.Add(i)
+		}
+
+		if !math.IsInf(bf.EstimatedCount(), 1) {
+			t.Errorf("Expected estimated count to be +Inf, got %f", bf.EstimatedCount())
+		}
+		if bf.FalsePositiveRate() != 1 {
+			t.Errorf("Expected false positive rate to be 1, got %f", bf.FalsePositiveRate())
+		}
+	})
+}
+
+func TestBloomFilterWithHasher(t *testing.T) {
+	t.Parallel()
+
+	fnvHash := func(value []byte) uint64 {
+		hashFunc := fnv.New64a()
+		hashFunc.Write(value)
+		return Hashing.Mix(hashFunc.Sum64())
+	}
+
+	t.Run("Byte slice keys", func(t *testing.T) {
+		t.Parallel()
+
+		bf, err := NewBloomFilterWithHasher[[]byte](100, 0.01, HasherFunc[[]byte](fnvHash))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		ids := [][]byte{{0xde, 0xad}, {0xbe, 0xef}, {0xca, 0xfe, 0xba, 0xbe}}
+		for _, id := range ids {
+			bf.Add(id)
+		}
+
+		for _, id := range ids {
+			if !bf.Check(id) {
+				t.Errorf("Expected %x to be in the filter, got false", id)
+			}
+		}
+		if bf.Check([]byte{0x00}) {
+			t.Errorf("Expected %x to not be in the filter, got true", []byte{0x00})
+		}
+	})
+
+	t.Run("Struct keys", func(t *testing.T) {
+		t.Parallel()
+
+		type edge struct {
+			src, dst string
+		}
+
+		strs := Hashing.NewHasher[string](0)
+		hasher := HasherFunc[edge](func(e edge) uint64 {
+			return Hashing.Mix(strs.Sum64(e.src) ^ strs.Sum64(e.dst)*31)
+		})
+
+		bf, _ := NewBloomFilterWithHasher[edge](100, 0.01, hasher)
+		bf.Add(edge{"nairobi", "nakuru"})
+		bf.Add(edge{"kisumu", "kitale"})
+
+		if !bf.Check(edge{"nairobi", "nakuru"}) {
+			t.Error("Expected nairobi-nakuru to be in the filter, got false")
+		}
+		if bf.Check(edge{"nakuru", "nairobi"}) {
+			t.Error("Expected nakuru-nairobi to not be in the filter, got true")
+		}
+	})
+
+	t.Run("Nil hasher", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := NewBloomFilterWithHasher[[]byte](100, 0.01, nil); err == nil {
+			t.Error("Expected an error for a nil hasher, got nil")
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package BloomFilter
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/AustinMusiku/dataStructures/Hashing"
+)
+
+// largest value a 4-bit counter can hold
+const maxCounter = 15
+
+type CountingBloomFilter[T Hashable] struct {
+	mu       sync.RWMutex
+	hasher   Hashing.Hasher[T]
+	counters []byte // two 4-bit counters per byte
+	size     uint64 // number of counters
+	hashes   uint   // number of counters incremented per element
+	count    int
+}
+
+// Create a new Counting Bloom Filter sized for the expected number of elements
+// and the target false-positive probability
+func NewCountingBloomFilter[T Hashable](elements uint, falsePositiveRate float64) (*CountingBloomFilter[T], error) {
+	if err := validateEstimates(elements, falsePositiveRate); err != nil {
+		return nil, err
+	}
+
+	size := optimalSize(elements, falsePositiveRate)
+	return &CountingBloomFilter[T]{
+		hasher:   Hashing.NewHasher[T](0),
+		counters: make([]byte, (size+1)/2),
+		size:     size,
+		hashes:   optimalHashes(size, elements),
+		count:    0,
+	}, nil
+}
+
+// Add elements to the filter.
+// Returns an error and leaves the filter unchanged if any counter would overflow
+func (c *CountingBloomFilter[T]) Add(value T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	h1, h2 := split(c.hasher.Sum64(value))
+
+	for i := uint(0); i < c.hashes; i++ {
+		location := nthLocation(h1, h2, i, c.size)
+
+		if c.get(location) == maxCounter {
+			c.rollback(h1, h2, i, 1)
+			return errors.New("counter overflow. Can't add to filter")
+		}
+		c.set(location, c.get(location)+1)
+	}
+
+	c.count++
+	return nil
+}
+
+// Remove elements from the filter.
+// Returns an error and leaves the filter unchanged if the value is not in the filter
+func (c *CountingBloomFilter[T]) Remove(value T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	h1, h2 := split(c.hasher.Sum64(value))
+
+	for i := uint(0); i < c.hashes; i++ {
+		location := nthLocation(h1, h2, i, c.size)
+
+		if c.get(location) == 0 {
+			c.rollback(h1, h2, i, -1)
+			return errors.New("counter underflow. Value is not in the filter")
+		}
+		c.set(location, c.get(location)-1)
+	}
+
+	c.count--
+	return nil
+}
+
+// Check if an element is in the filter
+func (c *CountingBloomFilter[T]) Check(value T) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	h1, h2 := split(c.hasher.Sum64(value))
+
+	for i := uint(0); i < c.hashes; i++ {
+		if c.get(nthLocation(h1, h2, i, c.size)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Clear the filter and reset the count
+func (c *CountingBloomFilter[T]) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counters = make([]byte, len(c.counters))
+	c.count = 0
+}
+
+// Get the number of counters in the filter
+func (c *CountingBloomFilter[T]) Size() uint64 {
+	return c.size
+}
+
+// Get the number of counters incremented for every element
+func (c *CountingBloomFilter[T]) Hashes() uint {
+	return c.hashes
+}
+
+// Undo the first n counter updates of an Add (delta 1) or Remove (delta -1)
+func (c *CountingBloomFilter[T]) rollback(h1, h2 uint64, n uint, delta int) {
+	for i := uint(0); i < n; i++ {
+		location := nthLocation(h1, h2, i, c.size)
+		c.set(location, byte(int(c.get(location))-delta))
+	}
+}
+
+// Returns the counter at index i.
+// Even indexes live in the low nibble of a byte and odd indexes in the high nibble
+func (c *CountingBloomFilter[T]) get(i uint64) byte {
+	return (c.counters[i/2] >> ((i % 2) * 4)) & 0x0f
+}
+
+// Sets the counter at index i
+func (c *CountingBloomFilter[T]) set(i uint64, value byte) {
+	shift := (i % 2) * 4
+	c.counters[i/2] = c.counters[i/2]&^(0x0f<<shift) | (value&0x0f)<<shift
+}
@@ -0,0 +1,105 @@
+package BloomFilter
+
+import "testing"
+
+func TestCountingBloomFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []string{"rick grimmes", "carl grimmes", "daryl dixon", "michonne", "rosita espinosa", "glenn rhee", "maggie greene", "abraham ford", "eugene porter", "sasha williams"}
+
+	t.Run("Add and remove", func(t *testing.T) {
+		t.Parallel()
+
+		cbf, err := NewCountingBloomFilter[string](100, 0.001)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		for _, testCase := range testCases {
+			if err := cbf.Add(testCase); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if !cbf.Check(testCase) {
+				t.Errorf("Expected \"%s\" to be in the filter, got false", testCase)
+			}
+		}
+
+		for _, testCase := range testCases[:5] {
+			if err := cbf.Remove(testCase); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if cbf.Check(testCase) {
+				t.Errorf("Expected \"%s\" to not be in the filter, got true", testCase)
+			}
+		}
+
+		for _, testCase := range testCases[5:] {
+			if !cbf.Check(testCase) {
+				t.Errorf("Expected \"%s\" to still be in the filter, got false", testCase)
+			}
+		}
+
+		if cbf.count != 5 {
+			t.Errorf("Expected count to be 5, got %d", cbf.count)
+		}
+	})
+
+	t.Run("Underflow", func(t *testing.T) {
+		t.Parallel()
+
+		cbf, _ := NewCountingBloomFilter[string](100, 0.001)
+		cbf.Add("daryl dixon")
+
+		before := append([]byte(nil), cbf.counters...)
+
+		if err := cbf.Remove("negan"); err == nil {
+			t.Error("Expected an underflow error, got nil")
+		}
+
+		for i := range before {
+			if before[i] != cbf.counters[i] {
+				t.Fatalf("Expected counters to be unchanged after a failed remove")
+			}
+		}
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		t.Parallel()
+
+		cbf, _ := NewCountingBloomFilter[string](100, 0.001)
+
+		for i := 0; i < maxCounter; i++ {
+			if err := cbf.Add("daryl dixon"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		}
+
+		before := append([]byte(nil), cbf.counters...)
+
+		if err := cbf.Add("daryl dixon"); err == nil {
+			t.Error("Expected an overflow error, got nil")
+		}
+
+		for i := range before {
+			if before[i] != cbf.counters[i] {
+				t.Fatalf("Expected counters to be unchanged after a failed add")
+			}
+		}
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		t.Parallel()
+
+		cbf, _ := NewCountingBloomFilter[string](100, 0.001)
+		for _, testCase := range testCases {
+			cbf.Add(testCase)
+		}
+
+		cbf.Reset()
+		for _, testCase := range testCases {
+			if cbf.Check(testCase) {
+				t.Errorf("Expected filter to be empty, got \"%s\"", testCase)
+			}
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package BloomFilter
+
+import (
+	"errors"
+	"math"
+	"sync"
+
+	"github.com/AustinMusiku/dataStructures/Hashing"
+)
+
+const (
+	// each new sub-filter holds this many times the elements of the previous one
+	growthFactor = 2
+	// each new sub-filter has this many times the error rate of the previous one
+	tighteningRatio = 0.8
+)
+
+type ScalableBloomFilter[T Hashable] struct {
+	mu                sync.RWMutex
+	filters           []*BloomFilter[T]
+	capacities        []uint // number of elements each sub-filter was sized for
+	initialCapacity   uint
+	falsePositiveRate float64
+}
+
+// Create a new Scalable Bloom Filter that starts sized for initialCapacity elements
+// and keeps its overall false-positive probability below falsePositiveRate as it grows.
+//
+// Sub-filter i is sized for initialCapacity*2^i elements at a rate of P*(1-r)*r^i,
+// so the rates form a geometric series that sums to at most P
+func NewScalableBloomFilter[T Hashable](initialCapacity uint, falsePositiveRate float64) (*ScalableBloomFilter[T], error) {
+	if initialCapacity == 0 {
+		return nil, errors.New("initial capacity must be greater than 0")
+	}
+	if !(falsePositiveRate > 0 && falsePositiveRate < 1) {
+		return nil, errors.New("false positive rate must be between 0 and 1")
+	}
+
+	s := &ScalableBloomFilter[T]{
+		initialCapacity:   initialCapacity,
+		falsePositiveRate: falsePositiveRate,
+	}
+	s.grow()
+
+	return s, nil
+}
+
+// Add elements to the filter, adding a new sub-filter when the current one is full
+func (s *ScalableBloomFilter[T]) Add(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := len(s.filters) - 1
+	if uint(s.filters[current].count) >= s.capacities[current] {
+		s.grow()
+		current++
+	}
+
+	s.filters[current].Add(value)
+}
+
+// Check if an element is in any of the sub-filters
+func (s *ScalableBloomFilter[T]) Check(value T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, filter := range s.filters {
+		if filter.Check(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Clear the filter, dropping all but a fresh initial sub-filter
+func (s *ScalableBloomFilter[T]) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.filters = nil
+	s.capacities = nil
+	s.grow()
+}
+
+// Get the number of sub-filters
+func (s *ScalableBloomFilter[T]) Filters() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.filters)
+}
+
+// Append a sub-filter that is larger and stricter than the previous one
+func (s *ScalableBloomFilter[T]) grow() {
+	i := float64(len(s.filters))
+
+	capacity := uint(float64(s.initialCapacity) * math.Pow(growthFactor, i))
+	rate := s.falsePositiveRate * (1 - tighteningRatio) * math.Pow(tighteningRatio, i)
+
+	size := optimalSize(capacity, rate)
+	s.filters = append(s.filters, newBloomFilter[T](Hashing.NewHasher[T](0), size, optimalHashes(size, capacity)))
+	s.capacities = append(s.capacities, capacity)
+}
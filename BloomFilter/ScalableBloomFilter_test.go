@@ -0,0 +1,70 @@
+package BloomFilter
+
+import "testing"
+
+func TestScalableBloomFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Invalid parameters", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := NewScalableBloomFilter[int](0, 0.01); err == nil {
+			t.Error("Expected an error for a capacity of 0, got nil")
+		}
+		if _, err := NewScalableBloomFilter[int](10, 1); err == nil {
+			t.Error("Expected an error for a rate of 1, got nil")
+		}
+	})
+
+	t.Run("Grows past its initial capacity", func(t *testing.T) {
+		t.Parallel()
+
+		elements, rate := 10000, 0.01
+		sbf, _ := NewScalableBloomFilter[int](100, rate)
+
+		for i := 0; i < elements; i++ {
+			sbf.Add(i)
+		}
+
+		// 100 + 200 + ... + 6400 = 12700 >= 10000
+		if sbf.Filters() != 7 {
+			t.Errorf("Expected 7 sub-filters, got %d", sbf.Filters())
+		}
+
+		for i := 0; i < elements; i++ {
+			if !sbf.Check(i) {
+				t.Fatalf("Expected %d to be in the filter, got false", i)
+			}
+		}
+
+		falsePositives := 0
+		for i := elements; i < elements*2; i++ {
+			if sbf.Check(i) {
+				falsePositives++
+			}
+		}
+
+		// allow some headroom over the target rate
+		if observed := float64(falsePositives) / float64(elements); observed > rate*1.5 {
+			t.Errorf("Expected false positive rate close to %f, got %f", rate, observed)
+		}
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		t.Parallel()
+
+		sbf, _ := NewScalableBloomFilter[string](1, 0.01)
+		sbf.Add("rick grimmes")
+		sbf.Add("daryl dixon")
+		sbf.Add("michonne")
+
+		sbf.Reset()
+
+		if sbf.Filters() != 1 {
+			t.Errorf("Expected 1 sub-filter, got %d", sbf.Filters())
+		}
+		if sbf.Check("rick grimmes") {
+			t.Error("Expected filter to be empty, got \"rick grimmes\"")
+		}
+	})
+}
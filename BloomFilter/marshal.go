@@ -0,0 +1,142 @@
+package BloomFilter
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+)
+
+// Layout of an encoded filter (big endian):
+//
+//	version  uint8
+//	hashes   uint32
+//	size     uint64 (bits)
+//	count    uint64
+//	bitmap   [(size+7)/8]byte
+//	checksum uint32 (CRC-32 of everything before it)
+const (
+	formatVersion uint8 = 1
+	headerLen           = 1 + 4 + 8 + 8
+	checksumLen         = 4
+
+	// no false-positive rate a float64 can hold needs more hash functions than this
+	maxHashes = 1 << 11
+)
+
+// The largest bitmap, in bytes, accepted when decoding a filter. Encoded filters
+// come from outside the process, so this bounds what a corrupt or malicious
+// header can make the decoder allocate. Defaults to 1GiB
+var MaxEncodedSize uint64 = 1 << 30
+
+// MarshalBinary encodes the filter so it can be stored or sent to another process
+func (b *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	data := make([]byte, headerLen, headerLen+len(b.bitmap)+checksumLen)
+	data[0] = formatVersion
+	binary.BigEndian.PutUint32(data[1:5], uint32(b.hashes))
+	binary.BigEndian.PutUint64(data[5:13], b.size)
+	binary.BigEndian.PutUint64(data[13:21], uint64(b.count))
+	data = append(data, b.bitmap...)
+
+	return binary.BigEndian.AppendUint32(data, crc32.ChecksumIEEE(data)), nil
+}
+
+// UnmarshalBinary replaces the state of the filter with a previously encoded filter.
+// The filter keeps its hasher, which must match the hasher of the encoded filter
+func (b *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+	if b.hasher == nil {
+		return errors.New("filter has no hasher. Create it with a constructor first")
+	}
+	if len(data) < headerLen+checksumLen {
+		return errors.New("encoded filter is too short")
+	}
+
+	body, checksum := data[:len(data)-checksumLen], data[len(data)-checksumLen:]
+	if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(checksum) {
+		return errors.New("encoded filter checksum mismatch")
+	}
+
+	hashes, size, count, err := decodeHeader(body[:headerLen])
+	if err != nil {
+		return err
+	}
+
+	bitmap := body[headerLen:]
+	if uint64(len(bitmap)) != (size+7)/8 {
+		return errors.New("encoded filter bitmap length does not match its size")
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.bitmap = append([]byte(nil), bitmap...)
+	b.size = size
+	b.hashes = hashes
+	b.count = count
+	return nil
+}
+
+// WriteTo writes the encoded filter to w
+func (b *BloomFilter[T]) WriteTo(w io.Writer) (int64, error) {
+	data, err := b.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := w.Write(data)
+	return int64(n), err
+}
+
+// ReadFrom reads an encoded filter from r, replacing the state of the filter
+func (b *BloomFilter[T]) ReadFrom(r io.Reader) (int64, error) {
+	header := make([]byte, headerLen)
+	n, err := io.ReadFull(r, header)
+	read := int64(n)
+	if err != nil {
+		return read, err
+	}
+
+	_, size, _, err := decodeHeader(header)
+	if err != nil {
+		return read, err
+	}
+
+	// the buffer grows as the body arrives instead of trusting the header's size up front
+	remaining := int64((size+7)/8 + checksumLen)
+	body, err := io.ReadAll(io.LimitReader(r, remaining))
+	read += int64(len(body))
+	if err != nil {
+		return read, err
+	}
+	if int64(len(body)) < remaining {
+		return read, io.ErrUnexpectedEOF
+	}
+
+	return read, b.UnmarshalBinary(append(header, body...))
+}
+
+// Validates the fixed-size header and returns its fields
+func decodeHeader(header []byte) (uint, uint64, int, error) {
+	if header[0] != formatVersion {
+		return 0, 0, 0, errors.New("unsupported encoded filter version")
+	}
+
+	hashes := uint(binary.BigEndian.Uint32(header[1:5]))
+	size := binary.BigEndian.Uint64(header[5:13])
+	count := binary.BigEndian.Uint64(header[13:21])
+
+	if hashes == 0 || size == 0 {
+		return 0, 0, 0, errors.New("encoded filter has no bits or hash functions")
+	}
+	if hashes > maxHashes {
+		return 0, 0, 0, errors.New("encoded filter has too many hash functions")
+	}
+	if (size+7)/8 > MaxEncodedSize {
+		return 0, 0, 0, errors.New("encoded filter is too large")
+	}
+
+	return hashes, size, int(count), nil
+}
@@ -0,0 +1,147 @@
+package BloomFilter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	populate := func() *BloomFilter[string] {
+		bf, _ := NewBloomFilterWithRate[string](100, 0.01)
+		for _, value := range []string{"rick grimmes", "daryl dixon", "michonne", "glenn rhee"} {
+			bf.Add(value)
+		}
+		return bf
+	}
+
+	queries := []string{"rick grimmes", "daryl dixon", "michonne", "glenn rhee", "negan", "carol peletier", "the governor"}
+
+	t.Run("MarshalBinary and UnmarshalBinary", func(t *testing.T) {
+		t.Parallel()
+
+		bf := populate()
+
+		data, err := bf.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		loaded := NewBloomFilter[string](1)
+		if err := loaded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if loaded.Size() != bf.Size() || loaded.Hashes() != bf.Hashes() || loaded.count != bf.count {
+			t.Errorf("Expected parameters (%d, %d, %d), got (%d, %d, %d)",
+				bf.Size(), bf.Hashes(), bf.count,
+				loaded.Size(), loaded.Hashes(), loaded.count)
+		}
+
+		for _, query := range queries {
+			if loaded.Check(query) != bf.Check(query) {
+				t.Errorf("Expected Check(\"%s\") to be %v, got %v", query, bf.Check(query), loaded.Check(query))
+			}
+		}
+	})
+
+	t.Run("UnmarshalBinary while reading parameters", func(t *testing.T) {
+		t.Parallel()
+
+		data, _ := populate().MarshalBinary()
+		loaded := NewBloomFilter[string](1)
+
+		// run with -race to catch unsynchronised reads of size and hashes
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 100; i++ {
+				loaded.UnmarshalBinary(data)
+			}
+		}()
+
+		for i := 0; i < 100; i++ {
+			loaded.Size()
+			loaded.Hashes()
+		}
+		<-done
+	})
+
+	t.Run("WriteTo and ReadFrom", func(t *testing.T) {
+		t.Parallel()
+
+		bf := populate()
+
+		var buf bytes.Buffer
+		written, err := bf.WriteTo(&buf)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		loaded := NewBloomFilter[string](1)
+		read, err := loaded.ReadFrom(&buf)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if read != written {
+			t.Errorf("Expected to read %d bytes, got %d", written, read)
+		}
+
+		for _, query := range queries {
+			if loaded.Check(query) != bf.Check(query) {
+				t.Errorf("Expected Check(\"%s\") to be %v, got %v", query, bf.Check(query), loaded.Check(query))
+			}
+		}
+	})
+
+	t.Run("Reject corrupt data", func(t *testing.T) {
+		t.Parallel()
+
+		data, _ := populate().MarshalBinary()
+
+		corrupt := append([]byte(nil), data...)
+		corrupt[headerLen] ^= 0xff
+		if err := NewBloomFilter[string](1).UnmarshalBinary(corrupt); err == nil {
+			t.Error("Expected a checksum error, got nil")
+		}
+
+		if err := NewBloomFilter[string](1).UnmarshalBinary(data[:10]); err == nil {
+			t.Error("Expected an error for truncated data, got nil")
+		}
+
+		if _, err := NewBloomFilter[string](1).ReadFrom(bytes.NewReader(data[:len(data)-1])); err == nil {
+			t.Error("Expected an error for a truncated stream, got nil")
+		}
+	})
+
+	t.Run("Reject absurd headers", func(t *testing.T) {
+		t.Parallel()
+
+		header := func(hashes uint32, size uint64) []byte {
+			data := make([]byte, headerLen)
+			data[0] = formatVersion
+			binary.BigEndian.PutUint32(data[1:5], hashes)
+			binary.BigEndian.PutUint64(data[5:13], size)
+			return data
+		}
+
+		// a header claiming a 1TiB bitmap must not allocate it before reading a body
+		if _, err := NewBloomFilter[string](1).ReadFrom(bytes.NewReader(header(3, (1<<40)*8))); err == nil {
+			t.Error("Expected an error for an oversized bitmap, got nil")
+		}
+
+		// under the limit, only the bytes that actually arrive are buffered
+		if _, err := NewBloomFilter[string](1).ReadFrom(bytes.NewReader(header(3, MaxEncodedSize*8))); err != io.ErrUnexpectedEOF {
+			t.Errorf("Expected %v for a missing body, got %v", io.ErrUnexpectedEOF, err)
+		}
+
+		if _, err := NewBloomFilter[string](1).ReadFrom(bytes.NewReader(header(math.MaxUint32, 64))); err == nil {
+			t.Error("Expected an error for too many hash functions, got nil")
+		}
+	})
+}
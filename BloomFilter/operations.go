@@ -0,0 +1,80 @@
+package BloomFilter
+
+import "errors"
+
+// Union returns a new filter containing the elements of both filters.
+// The count of the result is the sum of both counts and may include duplicates
+func (b *BloomFilter[T]) Union(other *BloomFilter[T]) (*BloomFilter[T], error) {
+	return b.combine(other, func(x, y byte) byte { return x | y }, func(x, y int) int { return x + y })
+}
+
+// Intersect returns a new filter containing the elements present in both filters.
+// The count of the result is the smaller of both counts
+func (b *BloomFilter[T]) Intersect(other *BloomFilter[T]) (*BloomFilter[T], error) {
+	return b.combine(other, func(x, y byte) byte { return x & y }, func(x, y int) int {
+		if x < y {
+			return x
+		}
+		return y
+	})
+}
+
+// Merge adds all the elements of another filter to this filter in place
+func (b *BloomFilter[T]) Merge(other *BloomFilter[T]) error {
+	// copy the other filter first so that the two locks are never held together
+	bitmap, size, hashes, count := other.snapshot()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.compatible(size, hashes); err != nil {
+		return err
+	}
+
+	for i := range b.bitmap {
+		b.bitmap[i] |= bitmap[i]
+	}
+	b.count += count
+
+	return nil
+}
+
+// Combines the bitmaps of two filters byte by byte into a new filter
+func (b *BloomFilter[T]) combine(other *BloomFilter[T], op func(x, y byte) byte, counter func(x, y int) int) (*BloomFilter[T], error) {
+	bitmap, size, hashes, count := other.snapshot()
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if err := b.compatible(size, hashes); err != nil {
+		return nil, err
+	}
+
+	result := newBloomFilter(b.hasher, b.size, b.hashes)
+	for i := range result.bitmap {
+		result.bitmap[i] = op(b.bitmap[i], bitmap[i])
+	}
+	result.count = counter(b.count, count)
+
+	return result, nil
+}
+
+// Returns a copy of the filter's state
+func (b *BloomFilter[T]) snapshot() ([]byte, uint64, uint, int) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return append([]byte(nil), b.bitmap...), b.size, b.hashes, b.count
+}
+
+// Returns an error if a filter with the given parameters can't be combined with this one.
+// Hashers can't be compared, so both filters are trusted to hash values the same way
+func (b *BloomFilter[T]) compatible(size uint64, hashes uint) error {
+	if b.size != size {
+		return errors.New("filters have different sizes")
+	}
+	if b.hashes != hashes {
+		return errors.New("filters have a different number of hash functions")
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package BloomFilter
+
+import "testing"
+
+func TestOperations(t *testing.T) {
+	t.Parallel()
+
+	newFilters := func() (*BloomFilter[string], *BloomFilter[string]) {
+		a, _ := NewBloomFilterWithRate[string](100, 0.001)
+		b, _ := NewBloomFilterWithRate[string](100, 0.001)
+
+		a.Add("rick grimmes")
+		a.Add("daryl dixon")
+		b.Add("daryl dixon")
+		b.Add("michonne")
+
+		return a, b
+	}
+
+	t.Run("Union", func(t *testing.T) {
+		t.Parallel()
+
+		a, b := newFilters()
+		union, err := a.Union(b)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		for _, value := range []string{"rick grimmes", "daryl dixon", "michonne"} {
+			if !union.Check(value) {
+				t.Errorf("Expected \"%s\" to be in the union, got false", value)
+			}
+		}
+		if union.count != 4 {
+			t.Errorf("Expected count to be 4, got %d", union.count)
+		}
+	})
+
+	t.Run("Intersect", func(t *testing.T) {
+		t.Parallel()
+
+		a, b := newFilters()
+		intersection, err := a.Intersect(b)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if !intersection.Check("daryl dixon") {
+			t.Error("Expected \"daryl dixon\" to be in the intersection, got false")
+		}
+		for _, value := range []string{"rick grimmes", "michonne"} {
+			if intersection.Check(value) {
+				t.Errorf("Expected \"%s\" to not be in the intersection, got true", value)
+			}
+		}
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		t.Parallel()
+
+		a, b := newFilters()
+		if err := a.Merge(b); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		for _, value := range []string{"rick grimmes", "daryl dixon", "michonne"} {
+			if !a.Check(value) {
+				t.Errorf("Expected \"%s\" to be in the merged filter, got false", value)
+			}
+		}
+
+		// merging a filter into itself must not deadlock
+		if err := a.Merge(a); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("Incompatible filters", func(t *testing.T) {
+		t.Parallel()
+
+		a, _ := newFilters()
+		differentSize := NewBloomFilter[string](64)
+		differentHashes := newBloomFilter(a.hasher, a.Size(), a.Hashes()+1)
+
+		if _, err := a.Union(differentSize); err == nil {
+			t.Error("Expected an error for filters of different sizes, got nil")
+		}
+		if _, err := a.Intersect(differentHashes); err == nil {
+			t.Error("Expected an error for filters with different hash functions, got nil")
+		}
+		if err := a.Merge(differentSize); err == nil {
+			t.Error("Expected an error for filters of different sizes, got nil")
+		}
+	})
+}
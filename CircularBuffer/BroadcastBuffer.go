@@ -0,0 +1,209 @@
+package CircularBuffer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// What a broadcast buffer does when the slowest subscriber is a full buffer behind
+type OverflowPolicy int
+
+const (
+	// Writes wait (or fail) until the slowest subscriber has read an item
+	Block OverflowPolicy = iota
+	// Writes overwrite the oldest item and subscribers that haven't read it drop it
+	Overwrite
+)
+
+// Returned by a subscriber that fell so far behind that items were overwritten
+// before it could read them. The next read returns the oldest item still available
+type DroppedError struct {
+	Count uint64
+}
+
+func (e *DroppedError) Error() string {
+	return fmt.Sprintf("subscriber fell behind. Dropped %d items", e.Count)
+}
+
+// A ring buffer with one writer and many subscribers that each read every item
+// at their own pace. Items are numbered by a sequence that only grows, and
+// every subscriber keeps the sequence of the next item it will read
+type broadcastBuffer[T any] struct {
+	mu          sync.Mutex
+	items       []T
+	written     uint64 // sequence of the next item to be written
+	policy      OverflowPolicy
+	subscribers map[*subscriber[T]]struct{}
+	signal      // notified whenever items are written or read
+}
+
+type subscriber[T any] struct {
+	buffer *broadcastBuffer[T]
+	next   uint64 // sequence of the next item to read
+}
+
+// Create a broadcast buffer that holds up to size items for its slowest subscriber.
+// A size of 0 is rounded up to 1
+func NewBroadcastBuffer[T any](size uint, policy OverflowPolicy) *broadcastBuffer[T] {
+	if size == 0 {
+		size = 1
+	}
+
+	return &broadcastBuffer[T]{
+		items:       make([]T, size),
+		policy:      policy,
+		subscribers: make(map[*subscriber[T]]struct{}),
+	}
+}
+
+// Add a subscriber that receives every item written from now on
+func (b *broadcastBuffer[T]) Subscribe() *subscriber[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s := &subscriber[T]{buffer: b, next: b.written}
+	b.subscribers[s] = struct{}{}
+	return s
+}
+
+// Add items to the buffer for every subscriber.
+// With the Block policy, returns an error if the slowest subscriber has a full buffer to read
+func (b *broadcastBuffer[T]) Write(data T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.isFull() {
+		return errors.New("buffer is full. Can't write to buffer")
+	}
+
+	b.push(data)
+	return nil
+}
+
+// Add items to the buffer for every subscriber, waiting for the slowest
+// subscriber to catch up if needed. Returns the context's error if it is
+// cancelled before the item is written
+func (b *broadcastBuffer[T]) WriteWait(ctx context.Context, data T) error {
+	for {
+		b.mu.Lock()
+		if !b.isFull() {
+			b.push(data)
+			b.mu.Unlock()
+			return nil
+		}
+		changed := b.waitChan()
+		b.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Get the number of items the buffer can hold
+func (b *broadcastBuffer[T]) Cap() int {
+	return len(b.items)
+}
+
+// Consume the next item. Returns a *DroppedError if items were overwritten
+// before they could be read
+func (s *subscriber[T]) Read() (*T, error) {
+	b := s.buffer
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if s.next == b.written {
+		return nil, errors.New("buffer is empty. Can't read from buffer")
+	}
+
+	return s.pop()
+}
+
+// Consume the next item, waiting for one to be written if needed.
+// Returns the context's error if it is cancelled before an item is read
+func (s *subscriber[T]) ReadWait(ctx context.Context) (*T, error) {
+	b := s.buffer
+
+	for {
+		b.mu.Lock()
+		if s.next != b.written {
+			current, err := s.pop()
+			b.mu.Unlock()
+			return current, err
+		}
+		changed := b.waitChan()
+		b.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Get the number of items waiting to be read by the subscriber
+func (s *subscriber[T]) Len() int {
+	b := s.buffer
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if pending := b.written - s.next; pending < uint64(len(b.items)) {
+		return int(pending)
+	}
+	return len(b.items)
+}
+
+// Stop receiving items. Writers no longer wait for this subscriber
+func (s *subscriber[T]) Unsubscribe() {
+	b := s.buffer
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.subscribers, s)
+	b.notify()
+}
+
+// Returns true if writing would overwrite an item the slowest subscriber hasn't read.
+// Always false with the Overwrite policy. Must be called with the lock held
+func (b *broadcastBuffer[T]) isFull() bool {
+	if b.policy == Overwrite {
+		return false
+	}
+
+	for s := range b.subscribers {
+		if b.written-s.next >= uint64(len(b.items)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Must be called with the lock held
+func (b *broadcastBuffer[T]) push(data T) {
+	b.items[b.written%uint64(len(b.items))] = data
+	b.written++
+	b.notify()
+}
+
+// Consume the next item of a subscriber that has one pending.
+// Must be called with the lock held
+func (s *subscriber[T]) pop() (*T, error) {
+	b := s.buffer
+
+	// the oldest items have been overwritten, skip to the oldest one left
+	if oldest := b.written - uint64(len(b.items)); b.written > uint64(len(b.items)) && s.next < oldest {
+		dropped := oldest - s.next
+		s.next = oldest
+		return nil, &DroppedError{Count: dropped}
+	}
+
+	current := b.items[s.next%uint64(len(b.items))]
+	s.next++
+	b.notify()
+	return &current, nil
+}
@@ -0,0 +1,171 @@
+package CircularBuffer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBroadcastBuffer(t *testing.T) {
+	// read every pending item of a subscriber
+	drain := func(s *subscriber[int]) []int {
+		items := []int{}
+		for {
+			result, err := s.Read()
+			if err != nil {
+				return items
+			}
+			items = append(items, *result)
+		}
+	}
+
+	t.Run("Every subscriber reads every item", func(t *testing.T) {
+		buffer := NewBroadcastBuffer[int](4, Block)
+		a, b := buffer.Subscribe(), buffer.Subscribe()
+
+		buffer.Write(1)
+		buffer.Write(2)
+
+		if items := drain(a); !reflect.DeepEqual(items, []int{1, 2}) {
+			t.Errorf("Expected a to read %v, got %v", []int{1, 2}, items)
+		}
+
+		buffer.Write(3)
+
+		if items := drain(b); !reflect.DeepEqual(items, []int{1, 2, 3}) {
+			t.Errorf("Expected b to read %v, got %v", []int{1, 2, 3}, items)
+		}
+		if items := drain(a); !reflect.DeepEqual(items, []int{3}) {
+			t.Errorf("Expected a to read %v, got %v", []int{3}, items)
+		}
+	})
+
+	t.Run("Subscribers only see new items", func(t *testing.T) {
+		buffer := NewBroadcastBuffer[int](4, Block)
+		buffer.Write(1)
+
+		s := buffer.Subscribe()
+		buffer.Write(2)
+
+		if items := drain(s); !reflect.DeepEqual(items, []int{2}) {
+			t.Errorf("Expected to read %v, got %v", []int{2}, items)
+		}
+	})
+
+	t.Run("Block policy waits for the slowest subscriber", func(t *testing.T) {
+		buffer := NewBroadcastBuffer[int](2, Block)
+		fast, slow := buffer.Subscribe(), buffer.Subscribe()
+
+		buffer.Write(1)
+		buffer.Write(2)
+		drain(fast)
+
+		if err := buffer.Write(3); err == nil {
+			t.Errorf("Expected an error writing past the slowest subscriber, got nil")
+		}
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			slow.Read()
+		}()
+
+		if err := buffer.WriteWait(context.Background(), 3); err != nil {
+			t.Fatalf("Expected error to be nil, got %v", err)
+		}
+		if items := drain(slow); !reflect.DeepEqual(items, []int{2, 3}) {
+			t.Errorf("Expected slow subscriber to read %v, got %v", []int{2, 3}, items)
+		}
+	})
+
+	t.Run("Unsubscribe releases writers", func(t *testing.T) {
+		buffer := NewBroadcastBuffer[int](1, Block)
+		s := buffer.Subscribe()
+		buffer.Write(1)
+
+		s.Unsubscribe()
+		if err := buffer.Write(2); err != nil {
+			t.Errorf("Expected error to be nil, got %v", err)
+		}
+	})
+
+	t.Run("Overwrite policy drops items for lagging subscribers", func(t *testing.T) {
+		buffer := NewBroadcastBuffer[int](3, Overwrite)
+		s := buffer.Subscribe()
+
+		for i := 1; i <= 5; i++ {
+			if err := buffer.Write(i); err != nil {
+				t.Fatalf("Expected error to be nil, got %v", err)
+			}
+		}
+
+		if s.Len() != 3 {
+			t.Errorf("Expected 3 pending items, got %v", s.Len())
+		}
+
+		_, err := s.Read()
+		var dropped *DroppedError
+		if !errors.As(err, &dropped) {
+			t.Fatalf("Expected a DroppedError, got %v", err)
+		}
+		if dropped.Count != 2 {
+			t.Errorf("Expected 2 dropped items, got %v", dropped.Count)
+		}
+
+		if items := drain(s); !reflect.DeepEqual(items, []int{3, 4, 5}) {
+			t.Errorf("Expected to read %v, got %v", []int{3, 4, 5}, items)
+		}
+	})
+
+	t.Run("Size 0 is rounded up to 1", func(t *testing.T) {
+		for _, policy := range []OverflowPolicy{Block, Overwrite} {
+			buffer := NewBroadcastBuffer[int](0, policy)
+			if buffer.Cap() != 1 {
+				t.Errorf("Expected capacity to be 1, got %v", buffer.Cap())
+			}
+
+			// Block with no subscribers never waits
+			if err := buffer.Write(1); err != nil {
+				t.Errorf("Expected error to be nil, got %v", err)
+			}
+
+			s := buffer.Subscribe()
+			buffer.Write(2)
+			if current, err := s.Read(); err != nil || *current != 2 {
+				t.Errorf("Expected to read 2, got %v with error %v", current, err)
+			}
+		}
+	})
+
+	t.Run("Concurrent subscribers", func(t *testing.T) {
+		buffer := NewBroadcastBuffer[int](8, Block)
+		items := 500
+
+		var wg sync.WaitGroup
+		sums := make([]int, 4)
+		for i := range sums {
+			s := buffer.Subscribe()
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < items; j++ {
+					result, _ := s.ReadWait(context.Background())
+					sums[i] += *result
+				}
+			}(i)
+		}
+
+		for i := 1; i <= items; i++ {
+			buffer.WriteWait(context.Background(), i)
+		}
+		wg.Wait()
+
+		for i, sum := range sums {
+			if expected := items * (items + 1) / 2; sum != expected {
+				t.Errorf("Expected subscriber %v to sum to %v, got %v", i, expected, sum)
+			}
+		}
+	})
+}
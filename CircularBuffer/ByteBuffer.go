@@ -0,0 +1,218 @@
+package CircularBuffer
+
+import (
+	"errors"
+	"io"
+	"sync"
+)
+
+// Returned by a non-blocking byte buffer that has nothing to read yet
+var ErrEmpty = errors.New("buffer is empty. Can't read from buffer")
+
+// A bounded ring of bytes that can sit between an io.Writer and an io.Reader.
+// In blocking mode, reads wait for data and writes wait for space; otherwise
+// they return what they could do straight away. After Close, writes fail and
+// reads drain what is left before returning io.EOF
+type byteBuffer struct {
+	mu       sync.Mutex
+	buf      []byte
+	start    int // index of the oldest byte
+	length   int // number of bytes in the buffer
+	blocking bool
+	closed   bool
+	signal   // notified whenever bytes are added or removed
+}
+
+// Create a byte buffer that holds up to size bytes. A size of 0 is rounded up to 1,
+// so a blocking write always has room to make progress
+func NewByteBuffer(size uint, blocking bool) *byteBuffer {
+	if size == 0 {
+		size = 1
+	}
+
+	return &byteBuffer{
+		buf:      make([]byte, size),
+		blocking: blocking,
+	}
+}
+
+// Write implements io.Writer. A non-blocking buffer writes as many bytes as
+// fit and returns io.ErrShortWrite if that isn't all of p
+func (b *byteBuffer) Write(p []byte) (int, error) {
+	written := 0
+
+	for {
+		b.mu.Lock()
+		if b.closed {
+			b.mu.Unlock()
+			return written, io.ErrClosedPipe
+		}
+
+		n := b.copyIn(p[written:])
+		written += n
+		if n > 0 {
+			b.notify()
+		}
+
+		if written == len(p) {
+			b.mu.Unlock()
+			return written, nil
+		}
+		if !b.blocking {
+			b.mu.Unlock()
+			return written, io.ErrShortWrite
+		}
+
+		changed := b.waitChan()
+		b.mu.Unlock()
+		<-changed
+	}
+}
+
+// Read implements io.Reader. A non-blocking buffer returns ErrEmpty if there
+// is nothing to read, a blocking buffer waits for data
+func (b *byteBuffer) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.wait(); err != nil {
+		return 0, err
+	}
+
+	n := b.copyOut(p)
+	b.notify()
+	return n, nil
+}
+
+// ReadByte implements io.ByteReader
+func (b *byteBuffer) ReadByte() (byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.wait(); err != nil {
+		return 0, err
+	}
+
+	current := b.buf[b.start]
+	b.start = (b.start + 1) % len(b.buf)
+	b.length--
+	b.notify()
+	return current, nil
+}
+
+// WriteTo implements io.WriterTo. It drains the buffer into w and stops once
+// the buffer is empty, or for a blocking buffer, once it is closed and empty
+func (b *byteBuffer) WriteTo(w io.Writer) (int64, error) {
+	var total int64
+	chunk := make([]byte, len(b.buf))
+
+	for {
+		b.mu.Lock()
+		if err := b.wait(); err != nil {
+			b.mu.Unlock()
+			if err == io.EOF || err == ErrEmpty {
+				err = nil
+			}
+			return total, err
+		}
+
+		n := b.copyOut(chunk)
+		b.notify()
+		b.mu.Unlock()
+
+		written, err := w.Write(chunk[:n])
+		total += int64(written)
+		if err != nil {
+			return total, err
+		}
+		if written != n {
+			return total, io.ErrShortWrite
+		}
+	}
+}
+
+// Close stops writes. Readers get io.EOF once the remaining bytes are read
+func (b *byteBuffer) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	b.notify()
+	return nil
+}
+
+// Get the number of bytes in the buffer
+func (b *byteBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.length
+}
+
+// Get the number of bytes the buffer can hold
+func (b *byteBuffer) Cap() int {
+	return len(b.buf)
+}
+
+// Wait until there is something to read. Returns io.EOF if the buffer is
+// closed and empty, or ErrEmpty if a non-blocking buffer is empty.
+// Must be called with the lock held
+func (b *byteBuffer) wait() error {
+	for b.length == 0 {
+		if b.closed {
+			return io.EOF
+		}
+		if !b.blocking {
+			return ErrEmpty
+		}
+
+		changed := b.waitChan()
+		b.mu.Unlock()
+		<-changed
+		b.mu.Lock()
+	}
+	return nil
+}
+
+// Copy as much of p as fits after the newest byte, in at most two segments.
+// Must be called with the lock held. Returns the number of bytes copied
+func (b *byteBuffer) copyIn(p []byte) int {
+	free := len(b.buf) - b.length
+	if len(p) > free {
+		p = p[:free]
+	}
+	if len(p) == 0 {
+		return 0
+	}
+
+	end := (b.start + b.length) % len(b.buf)
+	n := copy(b.buf[end:], p)
+	copy(b.buf, p[n:])
+
+	b.length += len(p)
+	return len(p)
+}
+
+// Move the oldest bytes into p, in at most two segments.
+// Must be called with the lock held. Returns the number of bytes moved
+func (b *byteBuffer) copyOut(p []byte) int {
+	count := b.length
+	if len(p) < count {
+		count = len(p)
+	}
+
+	end := b.start + count
+	if end > len(b.buf) {
+		end = len(b.buf)
+	}
+	n := copy(p, b.buf[b.start:end])
+	copy(p[n:count], b.buf)
+
+	b.start = (b.start + count) % len(b.buf)
+	b.length -= count
+	return count
+}
@@ -0,0 +1,121 @@
+package CircularBuffer
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestByteBuffer(t *testing.T) {
+	t.Run("Write and read across the wrap point", func(t *testing.T) {
+		buffer := NewByteBuffer(8, false)
+		buffer.Write([]byte("abcdef"))
+
+		p := make([]byte, 4)
+		if n, err := buffer.Read(p); n != 4 || err != nil {
+			t.Fatalf("Expected to read 4 bytes, got %v and %v", n, err)
+		}
+
+		if n, err := buffer.Write([]byte("ghijk")); n != 5 || err != nil {
+			t.Fatalf("Expected to write 5 bytes, got %v and %v", n, err)
+		}
+
+		p = make([]byte, 16)
+		n, _ := buffer.Read(p)
+		if string(p[:n]) != "efghijk" {
+			t.Errorf("Expected to read %q, got %q", "efghijk", p[:n])
+		}
+	})
+
+	t.Run("Non-blocking short write and empty read", func(t *testing.T) {
+		buffer := NewByteBuffer(4, false)
+
+		n, err := buffer.Write([]byte("abcdef"))
+		if n != 4 || err != io.ErrShortWrite {
+			t.Errorf("Expected 4 bytes and %v, got %v and %v", io.ErrShortWrite, n, err)
+		}
+
+		buffer.Read(make([]byte, 4))
+		if _, err := buffer.Read(make([]byte, 4)); err != ErrEmpty {
+			t.Errorf("Expected %v, got %v", ErrEmpty, err)
+		}
+		if _, err := buffer.ReadByte(); err != ErrEmpty {
+			t.Errorf("Expected %v, got %v", ErrEmpty, err)
+		}
+	})
+
+	t.Run("Size 0 is rounded up to 1", func(t *testing.T) {
+		buffer := NewByteBuffer(0, true)
+		if buffer.Cap() != 1 {
+			t.Fatalf("Expected capacity to be 1, got %v", buffer.Cap())
+		}
+
+		// a blocking write hands over one byte at a time instead of waiting forever
+		go func() {
+			buffer.Write([]byte("go"))
+			buffer.Close()
+		}()
+
+		data, err := io.ReadAll(buffer)
+		if err != nil || string(data) != "go" {
+			t.Errorf("Expected to read %q, got %q with error %v", "go", data, err)
+		}
+	})
+
+	t.Run("ReadByte", func(t *testing.T) {
+		buffer := NewByteBuffer(4, false)
+		buffer.Write([]byte("go"))
+
+		for _, expected := range []byte("go") {
+			current, err := buffer.ReadByte()
+			if err != nil {
+				t.Fatalf("Expected error to be nil, got %v", err)
+			}
+			if current != expected {
+				t.Errorf("Expected to read %q, got %q", expected, current)
+			}
+		}
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		buffer := NewByteBuffer(4, false)
+		buffer.Write([]byte("ab"))
+		buffer.Close()
+
+		if _, err := buffer.Write([]byte("c")); err != io.ErrClosedPipe {
+			t.Errorf("Expected %v, got %v", io.ErrClosedPipe, err)
+		}
+
+		data, err := io.ReadAll(buffer)
+		if err != nil {
+			t.Fatalf("Expected error to be nil, got %v", err)
+		}
+		if string(data) != "ab" {
+			t.Errorf("Expected to read %q, got %q", "ab", data)
+		}
+
+		if _, err := buffer.ReadByte(); err != io.EOF {
+			t.Errorf("Expected %v, got %v", io.EOF, err)
+		}
+	})
+
+	t.Run("Blocking pipe between goroutines", func(t *testing.T) {
+		buffer := NewByteBuffer(16, true)
+		input := strings.Repeat("the quick brown fox jumps over the lazy dog. ", 100)
+
+		go func() {
+			io.Copy(buffer, strings.NewReader(input))
+			buffer.Close()
+		}()
+
+		var output bytes.Buffer
+		n, err := buffer.WriteTo(&output)
+		if err != nil {
+			t.Fatalf("Expected error to be nil, got %v", err)
+		}
+		if n != int64(len(input)) || output.String() != input {
+			t.Errorf("Expected to copy %v bytes unchanged, got %v", len(input), n)
+		}
+	})
+}
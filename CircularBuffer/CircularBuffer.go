@@ -1,18 +1,27 @@
 package CircularBuffer
 
 import (
+	"context"
 	"errors"
 	"sync"
 )
 
 type circularBuffer[T any] struct {
-	mu    sync.Mutex
-	items []T
-	read  int
-	write int
+	mu        sync.Mutex
+	items     []T
+	read      int
+	write     int
+	overwrite bool    // evict the oldest item instead of failing when full
+	onEvict   func(T) // called with every evicted item in overwrite mode
+	signal            // notified whenever items are added or removed
 }
 
+// Create a buffer that holds up to size items. A size of 0 is rounded up to 1
 func NewCircularBuffer[T any](size uint) *circularBuffer[T] {
+	if size == 0 {
+		size = 1
+	}
+
 	return &circularBuffer[T]{
 		items: make([]T, size),
 		read:  -1,
@@ -20,13 +29,67 @@ func NewCircularBuffer[T any](size uint) *circularBuffer[T] {
 	}
 }
 
+// Create a buffer that never rejects writes. When it is full, the newest
+// write evicts the oldest item. If onEvict is not nil, it is called with
+// every evicted item after the buffer is unlocked
+func NewOverwritingCircularBuffer[T any](size uint, onEvict func(T)) *circularBuffer[T] {
+	buffer := NewCircularBuffer[T](size)
+	buffer.overwrite = true
+	buffer.onEvict = onEvict
+	return buffer
+}
+
 // Add items to the buffer
 func (c *circularBuffer[T]) Write(data T) error {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	evicted, ok, err := c.push(data)
+	c.mu.Unlock()
+
+	if ok && c.onEvict != nil {
+		c.onEvict(evicted)
+	}
+	return err
+}
+
+// Add items to the buffer, waiting for space if the buffer is full.
+// Returns the context's error if it is cancelled before the item is written
+func (c *circularBuffer[T]) WriteWait(ctx context.Context, data T) error {
+	for {
+		c.mu.Lock()
+		if c.overwrite || !c.IsFull() {
+			evicted, ok, err := c.push(data)
+			c.mu.Unlock()
+
+			if ok && c.onEvict != nil {
+				c.onEvict(evicted)
+			}
+			return err
+		}
+		changed := c.waitChan()
+		c.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Add an item to the buffer, evicting the oldest item if the buffer is full
+// and in overwrite mode. Returns the evicted item and whether there was one.
+// Must be called with the lock held
+func (c *circularBuffer[T]) push(data T) (T, bool, error) {
+	var evicted T
+	ok := false
 
 	if c.IsFull() {
-		return errors.New("buffer is full. Can't write to buffer")
+		if !c.overwrite {
+			return evicted, false, errors.New("buffer is full. Can't write to buffer")
+		}
+
+		evicted, ok = c.items[c.read], true
+		c.read = (c.read + 1) % len(c.items)
 	}
 	if c.IsEmpty() {
 		c.read++
@@ -34,7 +97,8 @@ func (c *circularBuffer[T]) Write(data T) error {
 
 	c.write = (c.write + 1) % len(c.items)
 	c.items[c.write] = data
-	return nil
+	c.notify()
+	return evicted, ok, nil
 }
 
 // Consume items from the buffer
@@ -46,15 +110,179 @@ func (c *circularBuffer[T]) Read() (*T, error) {
 		return nil, errors.New("buffer is empty. Can't read from buffer")
 	}
 
+	return c.pop(), nil
+}
+
+// Consume items from the buffer, waiting for an item if the buffer is empty.
+// Returns the context's error if it is cancelled before an item is read
+func (c *circularBuffer[T]) ReadWait(ctx context.Context) (*T, error) {
+	for {
+		c.mu.Lock()
+		if !c.IsEmpty() {
+			current := c.pop()
+			c.mu.Unlock()
+			return current, nil
+		}
+		changed := c.waitChan()
+		c.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Remove the oldest item from a buffer that is not empty.
+// Must be called with the lock held
+func (c *circularBuffer[T]) pop() *T {
 	current := c.items[c.read]
+	c.notify()
 
+	// the last item has been consumed, the buffer is empty
 	if c.write == c.read {
-		c.write--
-		c.read--
+		c.read = -1
+		c.write = -1
+		return &current
 	}
 
 	c.read = (c.read + 1) % len(c.items)
-	return &current, nil
+	return &current
+}
+
+// Add a batch of items to the buffer, copying them in at most two segments.
+// Returns the number of items written. When the buffer is full before every item
+// is written, the remaining items are dropped and an error is returned.
+// In overwrite mode every item is written, evicting the oldest items as needed
+func (c *circularBuffer[T]) WriteMany(data []T) (int, error) {
+	c.mu.Lock()
+
+	requested, written := len(data), len(data)
+	var evicted []T
+
+	if c.overwrite {
+		// only the newest Cap() items can remain in the buffer
+		var dropped []T
+		if len(data) > len(c.items) {
+			dropped, data = data[:len(data)-len(c.items)], data[len(data)-len(c.items):]
+		}
+
+		if overflow := len(data) - (len(c.items) - c.len()); overflow > 0 {
+			if c.onEvict != nil {
+				evicted = make([]T, overflow, overflow+len(dropped))
+				c.copyOut(evicted)
+			}
+			c.discard(overflow)
+		}
+		if c.onEvict != nil {
+			evicted = append(evicted, dropped...)
+		}
+	} else if free := len(c.items) - c.len(); len(data) > free {
+		data = data[:free]
+		written = free
+	}
+
+	c.copyIn(data)
+	if len(data) > 0 {
+		c.notify()
+	}
+	c.mu.Unlock()
+
+	for _, item := range evicted {
+		c.onEvict(item)
+	}
+
+	if written < requested {
+		return written, errors.New("buffer is full. Can't write all items to buffer")
+	}
+	return written, nil
+}
+
+// Consume up to len(dst) items from the buffer into dst, copying them out in
+// at most two segments. Returns the number of items read
+func (c *circularBuffer[T]) ReadMany(dst []T) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n := c.copyOut(dst)
+	if n > 0 {
+		c.discard(n)
+		c.notify()
+	}
+	return n
+}
+
+// Get the number of items in the buffer
+func (c *circularBuffer[T]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.len()
+}
+
+// Get the number of items the buffer can hold
+func (c *circularBuffer[T]) Cap() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.items)
+}
+
+// Returns the number of items in the buffer.
+// Must be called with the lock held
+func (c *circularBuffer[T]) len() int {
+	if c.IsEmpty() {
+		return 0
+	}
+	if c.write >= c.read {
+		return c.write - c.read + 1
+	}
+	return len(c.items) - c.read + c.write + 1
+}
+
+// Copy items into the buffer after the newest item.
+// Must be called with the lock held and enough free space for src
+func (c *circularBuffer[T]) copyIn(src []T) {
+	if len(src) == 0 {
+		return
+	}
+
+	start := (c.write + 1) % len(c.items)
+	n := copy(c.items[start:], src)
+	copy(c.items, src[n:])
+
+	if c.IsEmpty() {
+		c.read = start
+	}
+	c.write = (start + len(src) - 1) % len(c.items)
+}
+
+// Copy the oldest items into dst without consuming them.
+// Must be called with the lock held. Returns the number of items copied
+func (c *circularBuffer[T]) copyOut(dst []T) int {
+	count := c.len()
+	if len(dst) < count {
+		count = len(dst)
+	}
+	if count == 0 {
+		return 0
+	}
+
+	n := copy(dst[:count], c.items[c.read:])
+	copy(dst[n:count], c.items)
+	return count
+}
+
+// Drop the n oldest items from the buffer.
+// Must be called with the lock held and at least n items in the buffer
+func (c *circularBuffer[T]) discard(n int) {
+	if n == c.len() {
+		c.read = -1
+		c.write = -1
+		return
+	}
+	c.read = (c.read + n) % len(c.items)
 }
 
 // Return the next item to be consumed
@@ -62,17 +290,71 @@ func (c *circularBuffer[T]) Peek() *T {
 	return &c.items[c.read]
 }
 
-func (c *circularBuffer[T]) Resize(factor uint) error {
+// Return the i-th item from the oldest without consuming it
+func (c *circularBuffer[T]) At(i int) (*T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if i < 0 || i >= c.len() {
+		return nil, errors.New("index out of range")
+	}
+
+	current := c.items[(c.read+i)%len(c.items)]
+	return &current, nil
+}
+
+// Return a copy of the items from the oldest to the newest without consuming them
+func (c *circularBuffer[T]) Snapshot() []T {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	tempArr := make([]T, len(c.items)*int(factor))
+	items := make([]T, c.len())
+	c.copyOut(items)
+	return items
+}
+
+// Return an iterator over the items and their indexes from the oldest to the newest.
+// The buffer is locked while iterating, so yield must not call other buffer methods.
+// Iteration stops early when yield returns false
+func (c *circularBuffer[T]) All() func(yield func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
 
-	if elementsCopied := copy(tempArr, c.items); elementsCopied != len(c.items) {
-		return errors.New("failed to resize buffer. Couldn't copy elements")
+		for i, count := 0, c.len(); i < count; i++ {
+			if !yield(i, c.items[(c.read+i)%len(c.items)]) {
+				return
+			}
+		}
+	}
+}
+
+// Change the number of items the buffer can hold.
+// Items are kept in the order they will be read, starting at the front of the
+// new storage. Returns an error if the buffer holds more items than the new capacity
+func (c *circularBuffer[T]) Resize(capacity uint) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if capacity == 0 {
+		return errors.New("failed to resize buffer. Capacity must be greater than 0")
 	}
 
+	count := c.len()
+	if int(capacity) < count {
+		return errors.New("failed to resize buffer. Buffer holds more items than the new capacity")
+	}
+
+	tempArr := make([]T, capacity)
+	c.copyOut(tempArr)
+
 	c.items = tempArr
+	c.read, c.write = -1, -1
+	if count > 0 {
+		c.read, c.write = 0, count-1
+	}
+
+	c.notify()
 	return nil
 }
 
@@ -83,6 +365,7 @@ func (c *circularBuffer[T]) Clear() []T {
 
 	c.read = -1
 	c.write = -1
+	c.notify()
 	return c.items
 }
 
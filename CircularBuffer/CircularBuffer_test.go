@@ -1,9 +1,12 @@
 package CircularBuffer
 
 import (
+	"context"
 	"errors"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestCircularBuffer(t *testing.T) {
@@ -73,6 +76,29 @@ func TestCircularBuffer(t *testing.T) {
 		}
 	})
 
+	t.Run("Read the last item from buffer", func(t *testing.T) {
+		buffer := NewCircularBuffer[int](5)
+		buffer.Write(1)
+
+		if result, err := buffer.Read(); err != nil || *result != 1 {
+			t.Errorf("Expected to read 1, got %v with error %v", result, err)
+		}
+
+		// the drained buffer must not look full
+		if !buffer.IsEmpty() {
+			t.Error("Expected buffer to be empty, got not empty")
+		}
+		if buffer.IsFull() {
+			t.Error("Expected buffer not to be full, got full")
+		}
+		if buffer.Len() != 0 {
+			t.Errorf("Expected length to be 0, got %v", buffer.Len())
+		}
+		if err := buffer.Write(2); err != nil {
+			t.Errorf("Expected error to be nil, got %v", err)
+		}
+	})
+
 	t.Run("Peek at buffer", func(t *testing.T) {
 		buffer := NewCircularBuffer[int](5)
 		buffer.Write(1)
@@ -94,12 +120,11 @@ func TestCircularBuffer(t *testing.T) {
 		buffer.Write(4)
 		buffer.Write(5)
 
-		factor := 2
-		buffer.Resize(2)
+		buffer.Resize(10)
 
 		if len(buffer.items) != 10 {
 			t.Errorf("Expected buffer to have length %v, got %v",
-				len(buffer.items)*factor,
+				10,
 				len(buffer.items))
 		}
 
@@ -112,6 +137,63 @@ func TestCircularBuffer(t *testing.T) {
 		}
 	})
 
+	t.Run("Resize wrapped buffer", func(t *testing.T) {
+		buffer := NewCircularBuffer[int](4)
+		buffer.WriteMany([]int{0, 0, 1, 2})
+		buffer.Read()
+		buffer.Read()
+		buffer.WriteMany([]int{3, 4})
+
+		if err := buffer.Resize(6); err != nil {
+			t.Fatalf("Expected error to be nil, got %v", err)
+		}
+		if buffer.read != 0 || buffer.write != 3 {
+			t.Errorf("Expected read 0 and write 3, got %v and %v", buffer.read, buffer.write)
+		}
+
+		buffer.WriteMany([]int{5, 6})
+
+		dst := make([]int, 6)
+		buffer.ReadMany(dst)
+		if !reflect.DeepEqual(dst, []int{1, 2, 3, 4, 5, 6}) {
+			t.Errorf("Expected to read %v, got %v", []int{1, 2, 3, 4, 5, 6}, dst)
+		}
+	})
+
+	t.Run("Shrink buffer", func(t *testing.T) {
+		buffer := NewCircularBuffer[int](5)
+		buffer.WriteMany([]int{1, 2, 3})
+
+		if err := buffer.Resize(2); err == nil {
+			t.Errorf("Expected an error shrinking below the number of items, got nil")
+		}
+		if buffer.Cap() != 5 {
+			t.Errorf("Expected cap to stay 5, got %v", buffer.Cap())
+		}
+
+		if err := buffer.Resize(3); err != nil {
+			t.Fatalf("Expected error to be nil, got %v", err)
+		}
+		if !buffer.IsFull() {
+			t.Errorf("Expected buffer to be full")
+		}
+
+		if err := NewCircularBuffer[int](5).Resize(0); err == nil {
+			t.Errorf("Expected an error resizing to 0, got nil")
+		}
+	})
+
+	t.Run("Resize empty buffer", func(t *testing.T) {
+		buffer := NewCircularBuffer[int](5)
+		buffer.Write(1)
+		buffer.Read()
+
+		buffer.Resize(2)
+		if !buffer.IsEmpty() {
+			t.Errorf("Expected buffer to be empty")
+		}
+	})
+
 	t.Run("Clear buffer", func(t *testing.T) {
 		buffer := NewCircularBuffer[int](5)
 		buffer.Write(1)
@@ -190,3 +272,393 @@ func TestCircularBuffer(t *testing.T) {
 	})
 
 }
+
+func TestOverwritingCircularBuffer(t *testing.T) {
+	t.Run("Write to full buffer evicts the oldest item", func(t *testing.T) {
+		evicted := []int{}
+		buffer := NewOverwritingCircularBuffer(3, func(item int) {
+			evicted = append(evicted, item)
+		})
+
+		for i := 1; i <= 5; i++ {
+			if err := buffer.Write(i); err != nil {
+				t.Errorf("Expected error to be nil, got %v", err)
+			}
+		}
+
+		if !reflect.DeepEqual(evicted, []int{1, 2}) {
+			t.Errorf("Expected evicted items to be %v, got %v", []int{1, 2}, evicted)
+		}
+
+		for _, expected := range []int{3, 4, 5} {
+			result, err := buffer.Read()
+			if err != nil {
+				t.Fatalf("Expected error to be nil, got %v", err)
+			}
+			if *result != expected {
+				t.Errorf("Expected to read %v, got %v", expected, *result)
+			}
+		}
+
+		if !buffer.IsEmpty() {
+			t.Errorf("Expected buffer to be empty")
+		}
+	})
+
+	t.Run("Write without eviction callback", func(t *testing.T) {
+		buffer := NewOverwritingCircularBuffer[int](2, nil)
+		buffer.Write(1)
+		buffer.Write(2)
+
+		if err := buffer.Write(3); err != nil {
+			t.Errorf("Expected error to be nil, got %v", err)
+		}
+		if *buffer.Peek() != 2 {
+			t.Errorf("Expected next item to be 2, got %v", *buffer.Peek())
+		}
+	})
+
+	t.Run("Write to a buffer of size 0", func(t *testing.T) {
+		evicted := []int{}
+		buffer := NewOverwritingCircularBuffer(0, func(item int) {
+			evicted = append(evicted, item)
+		})
+		if buffer.Cap() != 1 {
+			t.Fatalf("Expected cap to be 1, got %v", buffer.Cap())
+		}
+
+		for i := 1; i <= 2; i++ {
+			if err := buffer.Write(i); err != nil {
+				t.Errorf("Expected error to be nil, got %v", err)
+			}
+		}
+		if !reflect.DeepEqual(evicted, []int{1}) || *buffer.Peek() != 2 {
+			t.Errorf("Expected to evict 1 and keep 2, evicted %v and kept %v", evicted, *buffer.Peek())
+		}
+	})
+
+	t.Run("Write after draining the buffer", func(t *testing.T) {
+		buffer := NewCircularBuffer[int](3)
+		buffer.Write(1)
+		buffer.Read()
+
+		if !buffer.IsEmpty() {
+			t.Errorf("Expected buffer to be empty after reading its only item")
+		}
+		if err := buffer.Write(2); err != nil {
+			t.Errorf("Expected error to be nil, got %v", err)
+		}
+
+		result, _ := buffer.Read()
+		if *result != 2 {
+			t.Errorf("Expected to read 2, got %v", *result)
+		}
+	})
+}
+
+func TestCircularBufferWait(t *testing.T) {
+	t.Run("ReadWait waits for a write", func(t *testing.T) {
+		buffer := NewCircularBuffer[int](2)
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			buffer.Write(1)
+		}()
+
+		result, err := buffer.ReadWait(context.Background())
+		if err != nil {
+			t.Fatalf("Expected error to be nil, got %v", err)
+		}
+		if *result != 1 {
+			t.Errorf("Expected to read 1, got %v", *result)
+		}
+	})
+
+	t.Run("WriteWait waits for a read", func(t *testing.T) {
+		buffer := NewCircularBuffer[int](1)
+		buffer.Write(1)
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			buffer.Read()
+		}()
+
+		if err := buffer.WriteWait(context.Background(), 2); err != nil {
+			t.Fatalf("Expected error to be nil, got %v", err)
+		}
+		if *buffer.Peek() != 2 {
+			t.Errorf("Expected next item to be 2, got %v", *buffer.Peek())
+		}
+	})
+
+	t.Run("Cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		empty := NewCircularBuffer[int](1)
+		if _, err := empty.ReadWait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected error to be %v, got %v", context.DeadlineExceeded, err)
+		}
+
+		full := NewCircularBuffer[int](1)
+		full.Write(1)
+		if err := full.WriteWait(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected error to be %v, got %v", context.DeadlineExceeded, err)
+		}
+	})
+
+	t.Run("Producers and consumers", func(t *testing.T) {
+		buffer := NewCircularBuffer[int](4)
+		producers, items := 4, 250
+
+		var wg sync.WaitGroup
+		for p := 0; p < producers; p++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 1; i <= items; i++ {
+					buffer.WriteWait(context.Background(), i)
+				}
+			}()
+		}
+
+		sums := make(chan int)
+		for consumer := 0; consumer < 2; consumer++ {
+			go func() {
+				sum := 0
+				for i := 0; i < producers*items/2; i++ {
+					result, _ := buffer.ReadWait(context.Background())
+					sum += *result
+				}
+				sums <- sum
+			}()
+		}
+
+		total := <-sums + <-sums
+		wg.Wait()
+
+		if expected := producers * items * (items + 1) / 2; total != expected {
+			t.Errorf("Expected items to sum to %v, got %v", expected, total)
+		}
+	})
+}
+
+func TestCircularBufferBatch(t *testing.T) {
+	t.Run("WriteMany and ReadMany across the wrap point", func(t *testing.T) {
+		buffer := NewCircularBuffer[int](5)
+		buffer.Write(0)
+		buffer.Write(0)
+		buffer.Read()
+		buffer.Read()
+
+		n, err := buffer.WriteMany([]int{1, 2, 3, 4, 5})
+		if err != nil {
+			t.Fatalf("Expected error to be nil, got %v", err)
+		}
+		if n != 5 {
+			t.Errorf("Expected to write 5 items, got %v", n)
+		}
+		if buffer.Len() != 5 || !buffer.IsFull() {
+			t.Errorf("Expected buffer to be full with 5 items, got %v", buffer.Len())
+		}
+
+		dst := make([]int, 3)
+		if n := buffer.ReadMany(dst); n != 3 {
+			t.Errorf("Expected to read 3 items, got %v", n)
+		}
+		if !reflect.DeepEqual(dst, []int{1, 2, 3}) {
+			t.Errorf("Expected to read %v, got %v", []int{1, 2, 3}, dst)
+		}
+
+		dst = make([]int, 10)
+		if n := buffer.ReadMany(dst); n != 2 {
+			t.Errorf("Expected to read 2 items, got %v", n)
+		}
+		if !reflect.DeepEqual(dst[:2], []int{4, 5}) {
+			t.Errorf("Expected to read %v, got %v", []int{4, 5}, dst[:2])
+		}
+		if !buffer.IsEmpty() {
+			t.Errorf("Expected buffer to be empty")
+		}
+	})
+
+	t.Run("WriteMany into a nearly full buffer", func(t *testing.T) {
+		buffer := NewCircularBuffer[int](3)
+		buffer.Write(1)
+
+		n, err := buffer.WriteMany([]int{2, 3, 4})
+		if err == nil {
+			t.Errorf("Expected an error, got nil")
+		}
+		if n != 2 {
+			t.Errorf("Expected to write 2 items, got %v", n)
+		}
+
+		dst := make([]int, 3)
+		buffer.ReadMany(dst)
+		if !reflect.DeepEqual(dst, []int{1, 2, 3}) {
+			t.Errorf("Expected to read %v, got %v", []int{1, 2, 3}, dst)
+		}
+	})
+
+	t.Run("WriteMany in overwrite mode", func(t *testing.T) {
+		evicted := []int{}
+		buffer := NewOverwritingCircularBuffer(3, func(item int) {
+			evicted = append(evicted, item)
+		})
+		buffer.WriteMany([]int{1, 2})
+
+		n, err := buffer.WriteMany([]int{3, 4, 5, 6, 7})
+		if err != nil {
+			t.Fatalf("Expected error to be nil, got %v", err)
+		}
+		if n != 5 {
+			t.Errorf("Expected to write 5 items, got %v", n)
+		}
+		if !reflect.DeepEqual(evicted, []int{1, 2, 3, 4}) {
+			t.Errorf("Expected evicted items to be %v, got %v", []int{1, 2, 3, 4}, evicted)
+		}
+
+		dst := make([]int, 3)
+		buffer.ReadMany(dst)
+		if !reflect.DeepEqual(dst, []int{5, 6, 7}) {
+			t.Errorf("Expected to read %v, got %v", []int{5, 6, 7}, dst)
+		}
+	})
+
+	t.Run("Len and Cap", func(t *testing.T) {
+		buffer := NewCircularBuffer[int](4)
+		if buffer.Len() != 0 || buffer.Cap() != 4 {
+			t.Errorf("Expected len 0 and cap 4, got %v and %v", buffer.Len(), buffer.Cap())
+		}
+
+		buffer.WriteMany([]int{1, 2, 3})
+		buffer.Read()
+		buffer.WriteMany([]int{4, 5})
+		if buffer.Len() != 4 {
+			t.Errorf("Expected len 4, got %v", buffer.Len())
+		}
+	})
+
+	t.Run("Len and Cap while writing and resizing", func(t *testing.T) {
+		buffer := NewOverwritingCircularBuffer[int](4, nil)
+
+		// run with -race to catch unsynchronised reads of the indexes and items
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 100; i++ {
+				buffer.Write(i)
+				buffer.Resize(uint(4 + i%2))
+			}
+		}()
+
+		for i := 0; i < 100; i++ {
+			if buffer.Len() > buffer.Cap() {
+				t.Errorf("Expected len to be at most cap, got %v and %v", buffer.Len(), buffer.Cap())
+			}
+		}
+		<-done
+	})
+}
+
+func BenchmarkCircularBuffer(b *testing.B) {
+	chunk := make([]int, 256)
+
+	b.Run("Write/Read", func(b *testing.B) {
+		buffer := NewCircularBuffer[int](1024)
+		for i := 0; i < b.N; i++ {
+			for _, item := range chunk {
+				buffer.Write(item)
+			}
+			for range chunk {
+				buffer.Read()
+			}
+		}
+	})
+
+	b.Run("WriteMany/ReadMany", func(b *testing.B) {
+		buffer := NewCircularBuffer[int](1024)
+		for i := 0; i < b.N; i++ {
+			buffer.WriteMany(chunk)
+			buffer.ReadMany(chunk)
+		}
+	})
+}
+
+func TestCircularBufferInspect(t *testing.T) {
+	// returns a wrapped buffer holding 3, 4, 5, 6
+	newWrappedBuffer := func() *circularBuffer[int] {
+		buffer := NewCircularBuffer[int](5)
+		buffer.WriteMany([]int{1, 2, 3, 4})
+		buffer.Read()
+		buffer.Read()
+		buffer.WriteMany([]int{5, 6})
+		return buffer
+	}
+
+	t.Run("At", func(t *testing.T) {
+		buffer := newWrappedBuffer()
+
+		for i, expected := range []int{3, 4, 5, 6} {
+			result, err := buffer.At(i)
+			if err != nil {
+				t.Fatalf("Expected error to be nil, got %v", err)
+			}
+			if *result != expected {
+				t.Errorf("Expected item %v to be %v, got %v", i, expected, *result)
+			}
+		}
+
+		for _, i := range []int{-1, 4} {
+			if _, err := buffer.At(i); err == nil {
+				t.Errorf("Expected an error for index %v, got nil", i)
+			}
+		}
+
+		if buffer.Len() != 4 {
+			t.Errorf("Expected At to not consume items, got len %v", buffer.Len())
+		}
+	})
+
+	t.Run("Snapshot", func(t *testing.T) {
+		buffer := newWrappedBuffer()
+
+		if snapshot := buffer.Snapshot(); !reflect.DeepEqual(snapshot, []int{3, 4, 5, 6}) {
+			t.Errorf("Expected snapshot to be %v, got %v", []int{3, 4, 5, 6}, snapshot)
+		}
+		if snapshot := NewCircularBuffer[int](3).Snapshot(); len(snapshot) != 0 {
+			t.Errorf("Expected snapshot of an empty buffer to be empty, got %v", snapshot)
+		}
+		if buffer.Len() != 4 {
+			t.Errorf("Expected Snapshot to not consume items, got len %v", buffer.Len())
+		}
+	})
+
+	t.Run("All", func(t *testing.T) {
+		buffer := newWrappedBuffer()
+
+		items := []int{}
+		buffer.All()(func(i int, item int) bool {
+			if i != len(items) {
+				t.Errorf("Expected index %v, got %v", len(items), i)
+			}
+			items = append(items, item)
+			return true
+		})
+
+		if !reflect.DeepEqual(items, []int{3, 4, 5, 6}) {
+			t.Errorf("Expected to iterate over %v, got %v", []int{3, 4, 5, 6}, items)
+		}
+
+		items = []int{}
+		buffer.All()(func(i int, item int) bool {
+			items = append(items, item)
+			return i < 1
+		})
+
+		if !reflect.DeepEqual(items, []int{3, 4}) {
+			t.Errorf("Expected iteration to stop after %v, got %v", []int{3, 4}, items)
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package CircularBuffer
+
+import (
+	"errors"
+	"math/bits"
+	"sync/atomic"
+)
+
+// Size of a CPU cache line. Indexes written by different goroutines are kept
+// on separate lines so that updating one doesn't invalidate the other
+const cacheLineSize = 64
+
+var (
+	errSPSCFull  = errors.New("buffer is full. Can't write to buffer")
+	errSPSCEmpty = errors.New("buffer is empty. Can't read from buffer")
+)
+
+// A lock-free ring buffer for exactly one writing goroutine and one reading goroutine.
+// head and tail increase forever and are masked to find their slot, so the
+// buffer is full when tail-head equals the capacity
+type spscBuffer[T any] struct {
+	_         [cacheLineSize]byte
+	head      atomic.Uint64 // next slot to read, only advanced by the reader
+	tailCache uint64        // the reader's last view of tail
+	_         [cacheLineSize - 16]byte
+	tail      atomic.Uint64 // next slot to write, only advanced by the writer
+	headCache uint64        // the writer's last view of head
+	_         [cacheLineSize - 16]byte
+	items     []T
+	mask      uint64
+}
+
+// Create a new single-producer single-consumer buffer.
+// The capacity is rounded up to the next power of two
+func NewSPSCBuffer[T any](size uint) *spscBuffer[T] {
+	capacity := uint64(1)
+	if size > 1 {
+		capacity = 1 << bits.Len64(uint64(size)-1)
+	}
+
+	return &spscBuffer[T]{
+		items: make([]T, capacity),
+		mask:  capacity - 1,
+	}
+}
+
+// Add items to the buffer. Must only be called from the writing goroutine
+func (s *spscBuffer[T]) Write(data T) error {
+	tail := s.tail.Load()
+
+	if tail-s.headCache == uint64(len(s.items)) {
+		// looks full, check whether the reader has moved on since we last looked
+		s.headCache = s.head.Load()
+		if tail-s.headCache == uint64(len(s.items)) {
+			return errSPSCFull
+		}
+	}
+
+	s.items[tail&s.mask] = data
+
+	// publish the item to the reader
+	s.tail.Store(tail + 1)
+	return nil
+}
+
+// Consume items from the buffer. Must only be called from the reading goroutine
+func (s *spscBuffer[T]) Read() (T, error) {
+	head := s.head.Load()
+
+	if head == s.tailCache {
+		// looks empty, check whether the writer has added items since we last looked
+		s.tailCache = s.tail.Load()
+		if head == s.tailCache {
+			var zero T
+			return zero, errSPSCEmpty
+		}
+	}
+
+	slot := head & s.mask
+	current := s.items[slot]
+
+	// drop the reference so the slot doesn't keep the item alive
+	var zero T
+	s.items[slot] = zero
+
+	// hand the slot back to the writer
+	s.head.Store(head + 1)
+	return current, nil
+}
+
+// Get the number of items in the buffer. Only a snapshot while the other goroutine is active
+func (s *spscBuffer[T]) Len() int {
+	head := s.head.Load()
+	return int(s.tail.Load() - head)
+}
+
+// Get the number of items the buffer can hold
+func (s *spscBuffer[T]) Cap() int {
+	return len(s.items)
+}
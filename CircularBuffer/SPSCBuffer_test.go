@@ -0,0 +1,134 @@
+package CircularBuffer
+
+import (
+	"runtime"
+	"testing"
+	"unsafe"
+)
+
+func TestSPSCBuffer(t *testing.T) {
+	t.Run("Create new buffer", func(t *testing.T) {
+		tests := map[uint]int{0: 1, 1: 1, 3: 4, 8: 8, 1000: 1024}
+
+		for size, expected := range tests {
+			if capacity := NewSPSCBuffer[int](size).Cap(); capacity != expected {
+				t.Errorf("Expected size %v to have capacity %v, got %v", size, expected, capacity)
+			}
+		}
+	})
+
+	t.Run("Indexes are on separate cache lines", func(t *testing.T) {
+		buffer := NewSPSCBuffer[int](4)
+		distance := unsafe.Offsetof(buffer.tail) - unsafe.Offsetof(buffer.head)
+
+		if distance < cacheLineSize {
+			t.Errorf("Expected head and tail to be at least %v bytes apart, got %v", cacheLineSize, distance)
+		}
+	})
+
+	t.Run("Write and read", func(t *testing.T) {
+		buffer := NewSPSCBuffer[int](2)
+
+		if _, err := buffer.Read(); err == nil {
+			t.Errorf("Expected an error reading an empty buffer, got nil")
+		}
+
+		buffer.Write(1)
+		buffer.Write(2)
+		if err := buffer.Write(3); err == nil {
+			t.Errorf("Expected an error writing to a full buffer, got nil")
+		}
+		if buffer.Len() != 2 {
+			t.Errorf("Expected len 2, got %v", buffer.Len())
+		}
+
+		for _, expected := range []int{1, 2} {
+			result, err := buffer.Read()
+			if err != nil {
+				t.Fatalf("Expected error to be nil, got %v", err)
+			}
+			if result != expected {
+				t.Errorf("Expected to read %v, got %v", expected, result)
+			}
+		}
+	})
+
+	t.Run("Concurrent producer and consumer", func(t *testing.T) {
+		buffer := NewSPSCBuffer[int](16)
+		items := 100000
+
+		go func() {
+			for i := 0; i < items; {
+				if buffer.Write(i) == nil {
+					i++
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}()
+
+		for expected := 0; expected < items; {
+			result, err := buffer.Read()
+			if err != nil {
+				runtime.Gosched()
+				continue
+			}
+			if result != expected {
+				t.Fatalf("Expected to read %v, got %v", expected, result)
+			}
+			expected++
+		}
+	})
+}
+
+func BenchmarkSPSC(b *testing.B) {
+	b.Run("SPSCBuffer", func(b *testing.B) {
+		buffer := NewSPSCBuffer[int](1024)
+		done := make(chan struct{})
+
+		go func() {
+			for i := 0; i < b.N; {
+				if _, err := buffer.Read(); err == nil {
+					i++
+				} else {
+					runtime.Gosched()
+				}
+			}
+			close(done)
+		}()
+
+		for i := 0; i < b.N; {
+			if buffer.Write(i) == nil {
+				i++
+			} else {
+				runtime.Gosched()
+			}
+		}
+		<-done
+	})
+
+	b.Run("circularBuffer", func(b *testing.B) {
+		buffer := NewCircularBuffer[int](1024)
+		done := make(chan struct{})
+
+		go func() {
+			for i := 0; i < b.N; {
+				if _, err := buffer.Read(); err == nil {
+					i++
+				} else {
+					runtime.Gosched()
+				}
+			}
+			close(done)
+		}()
+
+		for i := 0; i < b.N; {
+			if buffer.Write(i) == nil {
+				i++
+			} else {
+				runtime.Gosched()
+			}
+		}
+		<-done
+	})
+}
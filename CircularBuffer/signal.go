@@ -0,0 +1,24 @@
+package CircularBuffer
+
+// Wakes up goroutines waiting for a buffer to change. The channel is only
+// created once someone waits, and closed and dropped on the next change.
+// The zero value is ready to use. Must be used with the buffer's lock held
+type signal struct {
+	changed chan struct{}
+}
+
+// Returns a channel that is closed the next time the buffer changes
+func (s *signal) waitChan() <-chan struct{} {
+	if s.changed == nil {
+		s.changed = make(chan struct{})
+	}
+	return s.changed
+}
+
+// Wake up every goroutine waiting for the buffer to change
+func (s *signal) notify() {
+	if s.changed != nil {
+		close(s.changed)
+		s.changed = nil
+	}
+}
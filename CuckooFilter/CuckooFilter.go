@@ -0,0 +1,204 @@
+package CuckooFilter
+
+import (
+	"errors"
+	"math/bits"
+	"math/rand"
+	"sync"
+
+	"github.com/AustinMusiku/dataStructures/Hashing"
+)
+
+type Hashable = Hashing.Hashable
+
+// Number of relocations attempted before the filter is considered full
+const MaxKicks = 500
+
+type CuckooFilter[T Hashable] struct {
+	mu              sync.RWMutex
+	hasher          Hashing.Hasher[T]
+	rand            *rand.Rand
+	buckets         []uint32 // numBuckets*bucketSize fingerprints, 0 marks an empty slot
+	numBuckets      uint64   // always a power of two
+	bucketSize      uint
+	fingerprintBits uint
+	count           int
+}
+
+// Create a new Cuckoo Filter able to hold about capacity elements.
+// fingerprintBits (1-32) trades space for a lower false-positive rate and
+// bucketSize (1-8) is the number of fingerprints stored per bucket
+func NewCuckooFilter[T Hashable](capacity, fingerprintBits, bucketSize uint) (*CuckooFilter[T], error) {
+	if capacity == 0 {
+		return nil, errors.New("capacity must be greater than 0")
+	}
+	if fingerprintBits < 1 || fingerprintBits > 32 {
+		return nil, errors.New("fingerprint size must be between 1 and 32 bits")
+	}
+	if bucketSize < 1 || bucketSize > 8 {
+		return nil, errors.New("bucket size must be between 1 and 8")
+	}
+
+	numBuckets := nextPowerOfTwo(uint64((capacity + bucketSize - 1) / bucketSize))
+
+	return &CuckooFilter[T]{
+		hasher:          Hashing.NewHasher[T](0),
+		rand:            rand.New(rand.NewSource(1)),
+		buckets:         make([]uint32, numBuckets*uint64(bucketSize)),
+		numBuckets:      numBuckets,
+		bucketSize:      bucketSize,
+		fingerprintBits: fingerprintBits,
+		count:           0,
+	}, nil
+}
+
+// Add elements to the filter.
+// Returns an error and leaves the filter unchanged if there is no room for the element
+func (c *CuckooFilter[T]) Add(value T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fp, i1, i2 := c.locate(value)
+
+	if c.insert(i1, fp) || c.insert(i2, fp) {
+		c.count++
+		return nil
+	}
+
+	// both buckets are full. Evict fingerprints to their alternate buckets,
+	// remembering every swap so that they can be undone if we give up
+	type swap struct {
+		slot uint64
+		fp   uint32
+	}
+	swaps := make([]swap, 0, MaxKicks)
+
+	index := i1
+	if c.rand.Intn(2) == 1 {
+		index = i2
+	}
+
+	for kick := 0; kick < MaxKicks; kick++ {
+		slot := index*uint64(c.bucketSize) + uint64(c.rand.Intn(int(c.bucketSize)))
+
+		swaps = append(swaps, swap{slot, c.buckets[slot]})
+		fp, c.buckets[slot] = c.buckets[slot], fp
+
+		index = c.altIndex(index, fp)
+		if c.insert(index, fp) {
+			c.count++
+			return nil
+		}
+	}
+
+	for i := len(swaps) - 1; i >= 0; i-- {
+		c.buckets[swaps[i].slot] = swaps[i].fp
+	}
+
+	return errors.New("filter is full. Can't add to filter")
+}
+
+// Check if an element is in the filter
+func (c *CuckooFilter[T]) Check(value T) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	fp, i1, i2 := c.locate(value)
+	return c.find(i1, fp) >= 0 || c.find(i2, fp) >= 0
+}
+
+// Remove an element from the filter.
+// Returns false if the element is not in the filter.
+// Only remove elements that were added, otherwise another element may be removed instead
+func (c *CuckooFilter[T]) Remove(value T) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fp, i1, i2 := c.locate(value)
+
+	for _, index := range [2]uint64{i1, i2} {
+		if slot := c.find(index, fp); slot >= 0 {
+			c.buckets[slot] = 0
+			c.count--
+			return true
+		}
+	}
+
+	return false
+}
+
+// Get the number of elements in the filter
+func (c *CuckooFilter[T]) Count() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.count
+}
+
+// Get the fraction of slots that are in use
+func (c *CuckooFilter[T]) LoadFactor() float64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return float64(c.count) / float64(len(c.buckets))
+}
+
+// Clear the filter and reset the count
+func (c *CuckooFilter[T]) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.buckets = make([]uint32, len(c.buckets))
+	c.count = 0
+}
+
+// Returns the fingerprint and both candidate buckets of a value
+func (c *CuckooFilter[T]) locate(value T) (uint32, uint64, uint64) {
+	hash := c.hasher.Sum64(value)
+
+	// the fingerprint comes from the high bits and the bucket from the low bits
+	fp := uint32(hash>>32) & (1<<c.fingerprintBits - 1)
+	if fp == 0 {
+		fp = 1
+	}
+
+	i1 := hash & (c.numBuckets - 1)
+	return fp, i1, c.altIndex(i1, fp)
+}
+
+// Partial-key cuckoo hashing: the alternate bucket only depends on the
+// current bucket and the fingerprint, so it can be found without the value
+func (c *CuckooFilter[T]) altIndex(index uint64, fp uint32) uint64 {
+	return (index ^ Hashing.Mix(uint64(fp))) & (c.numBuckets - 1)
+}
+
+// Stores a fingerprint in the first empty slot of a bucket.
+// Returns false if the bucket is full
+func (c *CuckooFilter[T]) insert(index uint64, fp uint32) bool {
+	start := index * uint64(c.bucketSize)
+	for slot := start; slot < start+uint64(c.bucketSize); slot++ {
+		if c.buckets[slot] == 0 {
+			c.buckets[slot] = fp
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the slot holding a fingerprint in a bucket, -1 otherwise
+func (c *CuckooFilter[T]) find(index uint64, fp uint32) int64 {
+	start := index * uint64(c.bucketSize)
+	for slot := start; slot < start+uint64(c.bucketSize); slot++ {
+		if c.buckets[slot] == fp {
+			return int64(slot)
+		}
+	}
+	return -1
+}
+
+func nextPowerOfTwo(n uint64) uint64 {
+	if n <= 1 {
+		return 1
+	}
+	return 1 << bits.Len64(n-1)
+}
@@ -0,0 +1,147 @@
+package CuckooFilter
+
+import "testing"
+
+func TestCuckooFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []string{"rick grimmes", "carl grimmes", "daryl dixon", "michonne", "rosita espinosa", "glenn rhee", "maggie greene", "abraham ford", "eugene porter", "sasha williams"}
+
+	t.Run("Invalid parameters", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := NewCuckooFilter[int](0, 8, 4); err == nil {
+			t.Error("Expected an error for a capacity of 0, got nil")
+		}
+		if _, err := NewCuckooFilter[int](10, 33, 4); err == nil {
+			t.Error("Expected an error for a 33-bit fingerprint, got nil")
+		}
+		if _, err := NewCuckooFilter[int](10, 8, 0); err == nil {
+			t.Error("Expected an error for a bucket size of 0, got nil")
+		}
+	})
+
+	t.Run("Add, check and remove", func(t *testing.T) {
+		t.Parallel()
+
+		cf, err := NewCuckooFilter[string](100, 16, 4)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		for _, testCase := range testCases {
+			if err := cf.Add(testCase); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if !cf.Check(testCase) {
+				t.Errorf("Expected \"%s\" to be in the filter, got false", testCase)
+			}
+		}
+
+		if cf.Count() != len(testCases) {
+			t.Errorf("Expected count to be %d, got %d", len(testCases), cf.Count())
+		}
+
+		for _, testCase := range testCases[:5] {
+			if !cf.Remove(testCase) {
+				t.Errorf("Expected \"%s\" to be removed, got false", testCase)
+			}
+			if cf.Check(testCase) {
+				t.Errorf("Expected \"%s\" to not be in the filter, got true", testCase)
+			}
+		}
+
+		for _, testCase := range testCases[5:] {
+			if !cf.Check(testCase) {
+				t.Errorf("Expected \"%s\" to still be in the filter, got false", testCase)
+			}
+		}
+
+		if cf.Remove("negan") {
+			t.Error("Expected removing a missing value to return false, got true")
+		}
+
+		if cf.Count() != 5 {
+			t.Errorf("Expected count to be 5, got %d", cf.Count())
+		}
+	})
+
+	t.Run("False positive rate", func(t *testing.T) {
+		t.Parallel()
+
+		elements := 10000
+		cf, _ := NewCuckooFilter[int](uint(elements), 12, 4)
+
+		for i := 0; i < elements; i++ {
+			if err := cf.Add(i); err != nil {
+				t.Fatalf("Expected no error adding %d, got %v", i, err)
+			}
+		}
+
+		for i := 0; i < elements; i++ {
+			if !cf.Check(i) {
+				t.Fatalf("Expected %d to be in the filter, got false", i)
+			}
+		}
+
+		falsePositives := 0
+		for i := elements; i < elements*2; i++ {
+			if cf.Check(i) {
+				falsePositives++
+			}
+		}
+
+		// upper bound is 2*bucketSize/2^fingerprintBits ~ 0.002
+		if observed := float64(falsePositives) / float64(elements); observed > 0.004 {
+			t.Errorf("Expected false positive rate below 0.004, got %f", observed)
+		}
+	})
+
+	t.Run("Full filter", func(t *testing.T) {
+		t.Parallel()
+
+		cf, _ := NewCuckooFilter[int](8, 16, 2)
+
+		added := []int{}
+		var err error
+		for i := 0; err == nil; i++ {
+			if err = cf.Add(i); err == nil {
+				added = append(added, i)
+			}
+		}
+
+		if len(added) > 8 {
+			t.Errorf("Expected at most 8 elements in the filter, got %d", len(added))
+		}
+		if cf.Count() != len(added) {
+			t.Errorf("Expected count to be %d, got %d", len(added), cf.Count())
+		}
+
+		// a failed add must not lose any of the elements already in the filter
+		for _, value := range added {
+			if !cf.Check(value) {
+				t.Errorf("Expected %d to be in the filter, got false", value)
+			}
+		}
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		t.Parallel()
+
+		cf, _ := NewCuckooFilter[string](100, 16, 4)
+		for _, testCase := range testCases {
+			cf.Add(testCase)
+		}
+
+		cf.Reset()
+
+		if cf.Count() != 0 {
+			t.Errorf("Expected count to be 0, got %d", cf.Count())
+		}
+		for _, testCase := range testCases {
+			if cf.Check(testCase) {
+				t.Errorf("Expected filter to be empty, got \"%s\"", testCase)
+			}
+		}
+	})
+}
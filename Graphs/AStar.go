@@ -0,0 +1,68 @@
+package Graphs
+
+import (
+	"errors"
+	"math"
+
+	"github.com/AustinMusiku/dataStructures/Heap"
+)
+
+// Estimates the cost of the cheapest path between two vertices
+type Heuristic[K comparable] func(from, to K) float64
+
+// Compute the shortest path between two vertices using A* search. Vertices are
+// explored in order of their distance from src plus the heuristic's estimate of
+// their distance to dst, so a good heuristic explores far fewer vertices than Dijkstra.
+// The path is only guaranteed to be the shortest if the heuristic never overestimates.
+// A nil heuristic estimates 0 for every vertex, which is the same as Dijkstra.
+// Returns the keys of the vertices on the path, from src to dst, and its length
+func (g *Graph[K, V, E]) AStar(src, dst K, heuristic Heuristic[K]) ([]K, float64, error) {
+	from := g.GetVertex(src)
+	if from == nil {
+		return nil, 0, errors.New("source vertex does not exist")
+	}
+
+	to := g.GetVertex(dst)
+	if to == nil {
+		return nil, 0, errors.New("destination vertex does not exist")
+	}
+
+	if heuristic == nil {
+		heuristic = func(from, to K) float64 { return 0 }
+	}
+
+	distances, predecessors := g.initialDistances(src)
+
+	// the open set holds the vertices to explore, ordered by their estimated total cost
+	open := Heap.NewHeapOf[*vertex[K, V, E], float64]("min")
+	open.Insert(from, heuristic(src, dst))
+
+	for open.Size() > 0 {
+		current := open.Remove()
+		key := current.Value.key
+
+		// skip stale entries for vertices that were reached more cheaply
+		if current.Priority > distances[key]+heuristic(key, dst) {
+			continue
+		}
+
+		if key == dst {
+			paths := &Paths[K]{Source: src, Distances: distances, Predecessors: predecessors}
+			path, err := paths.PathTo(dst)
+			return path, distances[dst], err
+		}
+
+		for _, n := range sortedNeighbours(current.Value.neighbours) {
+			// a vertex is explored again if it is reached more cheaply, so
+			// heuristics that are admissible but not consistent still work
+			through := distances[key] + g.weight(n.data)
+			if through < distances[n.vertex.key] {
+				distances[n.vertex.key] = through
+				predecessors[n.vertex.key] = key
+				open.Insert(n.vertex, through+heuristic(n.vertex.key, dst))
+			}
+		}
+	}
+
+	return nil, math.Inf(1), errors.New("destination vertex is not reachable")
+}
@@ -0,0 +1,118 @@
+package Graphs
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAStar(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Matches Dijkstra with a zero heuristic", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewGraph(), eastAfrica)
+		zero := func(from, to string) float64 { return 0 }
+
+		for _, src := range eastAfrica.vertices {
+			for _, dst := range eastAfrica.vertices {
+				expectedPath, expectedDistance, _ := g.ShortestPathBetween(src, dst)
+
+				path, distance, err := g.AStar(src, dst, zero)
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				if !reflect.DeepEqual(path, expectedPath) || distance != expectedDistance {
+					t.Errorf("Expected %s - %s to be %v with distance %f, got %v with distance %f", src, dst, expectedPath, expectedDistance, path, distance)
+				}
+			}
+		}
+
+		path, distance, _ := g.AStar("kitale", "mombasa", nil)
+		if !reflect.DeepEqual(path, []string{"kitale", "eldoret", "nakuru", "nairobi", "mombasa"}) || distance != 872 {
+			t.Errorf("Expected a nil heuristic to find kitale - mombasa with distance 872, got %v with distance %f", path, distance)
+		}
+	})
+
+	t.Run("Grid with a manhattan heuristic", func(t *testing.T) {
+		t.Parallel()
+
+		type point struct{ x, y int }
+
+		// a 10x10 grid with a wall at x = 5 that only has a gap at y = 9
+		g := NewGraphOf[point, any, float64](false, nil)
+		for x := 0; x < 10; x++ {
+			for y := 0; y < 10; y++ {
+				g.AddVertex(point{x, y})
+			}
+		}
+		for x := 0; x < 10; x++ {
+			for y := 0; y < 10; y++ {
+				if x < 9 && (x != 4 && x != 5 || y == 9) {
+					g.AddEdge(point{x, y}, point{x + 1, y}, 1)
+				}
+				if y < 9 && x != 5 {
+					g.AddEdge(point{x, y}, point{x, y + 1}, 1)
+				}
+			}
+		}
+
+		manhattan := func(from, to point) float64 {
+			return math.Abs(float64(from.x-to.x)) + math.Abs(float64(from.y-to.y))
+		}
+
+		src, dst := point{0, 0}, point{9, 0}
+		expected, _ := g.BellmanFord(src)
+
+		path, distance, err := g.AStar(src, dst, manhattan)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if distance != expected.Distances[dst] {
+			t.Errorf("Expected distance to be %f, got %f", expected.Distances[dst], distance)
+		}
+		if len(path) != int(distance)+1 || path[0] != src || path[len(path)-1] != dst {
+			t.Errorf("Expected a path of %d vertices from %v to %v, got %v", int(distance)+1, src, dst, path)
+		}
+	})
+
+	t.Run("Inconsistent heuristic", func(t *testing.T) {
+		t.Parallel()
+
+		// the heuristic never overestimates, but it drops by more than the
+		// weight of a-c, so c is first reached the long way round and reopened
+		g := newTestGraph(NewDirectedGraph(), TestCase{
+			vertices: []string{"s", "a", "b", "c", "d"},
+			edges: []edge{
+				{"s", "a", 1}, {"s", "b", 1},
+				{"a", "c", 1}, {"b", "c", 3},
+				{"c", "d", 5},
+			},
+		})
+		estimates := map[string]float64{"s": 0, "a": 6, "b": 0, "c": 0, "d": 0}
+		heuristic := func(from, to string) float64 { return estimates[from] }
+
+		path, distance, _ := g.AStar("s", "d", heuristic)
+		if !reflect.DeepEqual(path, []string{"s", "a", "c", "d"}) || distance != 7 {
+			t.Errorf("Expected s-a-c-d with distance 7, got %v with distance %f", path, distance)
+		}
+	})
+
+	t.Run("Unreachable and missing vertices", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewGraph(), eastAfrica)
+		g.AddVertex("kigali")
+
+		if _, _, err := g.AStar("nairobi", "kigali", nil); err == nil {
+			t.Error("Expected an error for an unreachable vertex, got nil")
+		}
+		if _, _, err := g.AStar("addis ababa", "nairobi", nil); err == nil {
+			t.Error("Expected an error for a missing source, got nil")
+		}
+		if _, _, err := g.AStar("nairobi", "addis ababa", nil); err == nil {
+			t.Error("Expected an error for a missing destination, got nil")
+		}
+	})
+}
@@ -1,47 +1,75 @@
 package Graphs
 
-import "errors"
+import (
+	"errors"
+	"sort"
+)
 
-type vertex struct {
-	key        string
-	neighbours map[string]*neighbour
+type vertex[K comparable, V any, E any] struct {
+	key        K
+	value      V
+	index      int                       // order in which the vertex was added to its graph
+	neighbours map[K]*neighbour[K, V, E] // outgoing edges
+	incoming   map[K]*neighbour[K, V, E] // incoming edges, only kept for directed graphs
 }
 
-type neighbour struct {
-	weight float64
-	vertex *vertex
+type neighbour[K comparable, V any, E any] struct {
+	data   E
+	vertex *vertex[K, V, E]
 }
 
-type Graph struct {
-	vertices map[string]*vertex
+// A graph with vertices identified by keys of type K that carry a value of
+// type V, and edges that carry data of type E
+type Graph[K comparable, V any, E any] struct {
+	vertices  map[K]*vertex[K, V, E]
+	directed  bool
+	weight    func(E) float64 // returns the weight of an edge from its data
+	nextIndex int
 }
 
-// initialize a new graph
-func NewGraph() *Graph {
-	return &Graph{
-		vertices: make(map[string]*vertex),
+// initialize a new graph. weight returns the weight of an edge from its data,
+// if it is nil every edge has a weight of 1
+func NewGraphOf[K comparable, V any, E any](directed bool, weight func(E) float64) *Graph[K, V, E] {
+	if weight == nil {
+		weight = func(E) float64 { return 1 }
+	}
+
+	return &Graph[K, V, E]{
+		vertices: make(map[K]*vertex[K, V, E]),
+		directed: directed,
+		weight:   weight,
 	}
 }
 
 // initializes a new node and sets its key
-func NewVertex(key string) *vertex {
-	return &vertex{
+func newVertex[K comparable, V any, E any](key K) *vertex[K, V, E] {
+	return &vertex[K, V, E]{
 		key:        key,
-		neighbours: make(map[string]*neighbour),
+		neighbours: make(map[K]*neighbour[K, V, E]),
+		incoming:   make(map[K]*neighbour[K, V, E]),
 	}
 }
 
+// Returns true if edges only go one way
+func (g *Graph[K, V, E]) IsDirected() bool {
+	return g.directed
+}
+
 // Adds a new vertex to the graph
-func (g *Graph) AddVertex(key string) (*vertex, error) {
+func (g *Graph[K, V, E]) AddVertex(key K) (*vertex[K, V, E], error) {
 	if _, ok := g.vertices[key]; !ok {
-		g.vertices[key] = NewVertex(key)
+		v := newVertex[K, V, E](key)
+		v.index = g.nextIndex
+		g.nextIndex++
+
+		g.vertices[key] = v
 		return g.GetVertex(key), nil
 	}
 	return g.GetVertex(key), errors.New("vertex already exists")
 }
 
 // Returns a vertex from the graph
-func (g *Graph) GetVertex(key string) *vertex {
+func (g *Graph[K, V, E]) GetVertex(key K) *vertex[K, V, E] {
 	if _, ok := g.vertices[key]; !ok {
 		return nil
 	}
@@ -49,8 +77,30 @@ func (g *Graph) GetVertex(key string) *vertex {
 	return g.vertices[key]
 }
 
+// Returns the value carried by a vertex
+func (g *Graph[K, V, E]) VertexValue(key K) (V, error) {
+	v := g.GetVertex(key)
+	if v == nil {
+		var zero V
+		return zero, errors.New("vertex does not exist")
+	}
+
+	return v.value, nil
+}
+
+// Sets the value carried by a vertex
+func (g *Graph[K, V, E]) SetVertexValue(key K, value V) error {
+	v := g.GetVertex(key)
+	if v == nil {
+		return errors.New("vertex does not exist")
+	}
+
+	v.value = value
+	return nil
+}
+
 // Removes a vertex from the graph
-func (g *Graph) RemoveVertex(key string) (bool, error) {
+func (g *Graph[K, V, E]) RemoveVertex(key K) (bool, error) {
 	if _, ok := g.vertices[key]; !ok {
 		return false, errors.New("vertex does not exist")
 	}
@@ -59,13 +109,14 @@ func (g *Graph) RemoveVertex(key string) (bool, error) {
 
 	for _, vertex := range g.vertices {
 		delete(vertex.neighbours, key)
+		delete(vertex.incoming, key)
 	}
 
 	return true, nil
 }
 
 // Adds an edge between two vertices
-func (g *Graph) AddEdge(src, dst string, weight float64) (bool, error) {
+func (g *Graph[K, V, E]) AddEdge(src, dst K, data E) (bool, error) {
 	from := g.GetVertex(src)
 	if from == nil {
 		return false, errors.New("source vertex does not exist")
@@ -76,13 +127,44 @@ func (g *Graph) AddEdge(src, dst string, weight float64) (bool, error) {
 		return false, errors.New("destination vertex does not exist")
 	}
 
-	from.AddNeighbour(to, weight)
+	if g.directed {
+		from.addArc(to, data)
+	} else {
+		from.AddNeighbour(to, data)
+	}
 
 	return true, nil
 }
 
+// Returns the data carried by an edge
+func (g *Graph[K, V, E]) Edge(src, dst K) (E, error) {
+	var zero E
+
+	from := g.GetVertex(src)
+	if from == nil {
+		return zero, errors.New("source vertex does not exist")
+	}
+
+	n, ok := from.neighbours[dst]
+	if !ok {
+		return zero, errors.New("edge does not exist")
+	}
+
+	return n.data, nil
+}
+
+// Returns the weight of an edge
+func (g *Graph[K, V, E]) Weight(src, dst K) (float64, error) {
+	data, err := g.Edge(src, dst)
+	if err != nil {
+		return 0, err
+	}
+
+	return g.weight(data), nil
+}
+
 // Removes an edge between two nodes
-func (g *Graph) RemoveEdge(src, dst string) (bool, error) {
+func (g *Graph[K, V, E]) RemoveEdge(src, dst K) (bool, error) {
 	from := g.GetVertex(src)
 	to := g.GetVertex(dst)
 
@@ -95,19 +177,114 @@ func (g *Graph) RemoveEdge(src, dst string) (bool, error) {
 	}
 
 	delete(from.neighbours, dst)
-	delete(to.neighbours, src)
+	if g.directed {
+		delete(to.incoming, src)
+	} else {
+		delete(to.neighbours, src)
+	}
 	return true, nil
 }
 
+// Returns the keys of the vertices that a vertex has edges to,
+// in the order the vertices were added to the graph
+func (g *Graph[K, V, E]) OutNeighbours(key K) ([]K, error) {
+	v := g.GetVertex(key)
+	if v == nil {
+		return nil, errors.New("vertex does not exist")
+	}
+
+	return keys(v.neighbours), nil
+}
+
+// Returns the keys of the vertices that have edges to a vertex.
+// The same as OutNeighbours for undirected graphs
+func (g *Graph[K, V, E]) InNeighbours(key K) ([]K, error) {
+	v := g.GetVertex(key)
+	if v == nil {
+		return nil, errors.New("vertex does not exist")
+	}
+
+	if !g.directed {
+		return keys(v.neighbours), nil
+	}
+	return keys(v.incoming), nil
+}
+
+// Returns the number of edges leaving a vertex
+func (g *Graph[K, V, E]) OutDegree(key K) (int, error) {
+	v := g.GetVertex(key)
+	if v == nil {
+		return 0, errors.New("vertex does not exist")
+	}
+
+	return len(v.neighbours), nil
+}
+
+// Returns the number of edges entering a vertex.
+// The same as OutDegree for undirected graphs
+func (g *Graph[K, V, E]) InDegree(key K) (int, error) {
+	v := g.GetVertex(key)
+	if v == nil {
+		return 0, errors.New("vertex does not exist")
+	}
+
+	if !g.directed {
+		return len(v.neighbours), nil
+	}
+	return len(v.incoming), nil
+}
+
 // Add a new neighbour to a vertex
-func (v *vertex) AddNeighbour(vertex *vertex, weight float64) {
-	v.neighbours[vertex.key] = &neighbour{
-		weight: weight,
+func (v *vertex[K, V, E]) AddNeighbour(vertex *vertex[K, V, E], data E) {
+	v.neighbours[vertex.key] = &neighbour[K, V, E]{
+		data:   data,
 		vertex: vertex,
 	}
 
-	vertex.neighbours[v.key] = &neighbour{
-		weight: weight,
+	vertex.neighbours[v.key] = &neighbour[K, V, E]{
+		data:   data,
 		vertex: v,
 	}
 }
+
+// Add a one-way edge from a vertex to a neighbour
+func (v *vertex[K, V, E]) addArc(vertex *vertex[K, V, E], data E) {
+	v.neighbours[vertex.key] = &neighbour[K, V, E]{
+		data:   data,
+		vertex: vertex,
+	}
+
+	vertex.incoming[v.key] = &neighbour[K, V, E]{
+		data:   data,
+		vertex: v,
+	}
+}
+
+// Returns the vertices of the graph in the order they were added
+func (g *Graph[K, V, E]) sortedVertices() []*vertex[K, V, E] {
+	result := make([]*vertex[K, V, E], 0, len(g.vertices))
+	for _, v := range g.vertices {
+		result = append(result, v)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].index < result[j].index })
+	return result
+}
+
+// Returns a set of neighbours in the order their vertices were added to the graph
+func sortedNeighbours[K comparable, V any, E any](neighbours map[K]*neighbour[K, V, E]) []*neighbour[K, V, E] {
+	result := make([]*neighbour[K, V, E], 0, len(neighbours))
+	for _, n := range neighbours {
+		result = append(result, n)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].vertex.index < result[j].vertex.index })
+	return result
+}
+
+// Returns the keys of a set of neighbours in the order their vertices were added to the graph
+func keys[K comparable, V any, E any](neighbours map[K]*neighbour[K, V, E]) []K {
+	result := make([]K, 0, len(neighbours))
+	for _, n := range sortedNeighbours(neighbours) {
+		result = append(result, n.vertex.key)
+	}
+	return result
+}
@@ -1,6 +1,7 @@
 package Graphs
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -169,3 +170,203 @@ func TestGraph(t *testing.T) {
 		}
 	})
 }
+
+func TestDirectedGraph(t *testing.T) {
+	t.Parallel()
+
+	// a -> b, a -> c, c -> b
+	newGraph := func() *StringGraph {
+		g := NewDirectedGraph()
+		g.AddVertex("a")
+		g.AddVertex("b")
+		g.AddVertex("c")
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("a", "c", 1)
+		g.AddEdge("c", "b", 1)
+		return g
+	}
+
+	t.Run("Add an edge", func(t *testing.T) {
+		t.Parallel()
+
+		g := newGraph()
+
+		if !g.IsDirected() {
+			t.Error("Expected graph to be directed")
+		}
+
+		if len(g.vertices["a"].neighbours) != 2 {
+			t.Errorf("Expected a to have 2 neighbours, got %d", len(g.vertices["a"].neighbours))
+		}
+
+		if len(g.vertices["b"].neighbours) != 0 {
+			t.Errorf("Expected b to have 0 neighbours, got %d", len(g.vertices["b"].neighbours))
+		}
+	})
+
+	t.Run("Neighbours and degrees", func(t *testing.T) {
+		t.Parallel()
+
+		g := newGraph()
+
+		out, _ := g.OutNeighbours("a")
+		if !reflect.DeepEqual(out, []string{"b", "c"}) {
+			t.Errorf("Expected out-neighbours of a to be %v, got %v", []string{"b", "c"}, out)
+		}
+
+		in, _ := g.InNeighbours("b")
+		if !reflect.DeepEqual(in, []string{"a", "c"}) {
+			t.Errorf("Expected in-neighbours of b to be %v, got %v", []string{"a", "c"}, in)
+		}
+
+		if degree, _ := g.OutDegree("b"); degree != 0 {
+			t.Errorf("Expected out-degree of b to be 0, got %d", degree)
+		}
+
+		if degree, _ := g.InDegree("b"); degree != 2 {
+			t.Errorf("Expected in-degree of b to be 2, got %d", degree)
+		}
+
+		if _, err := g.InDegree("z"); err == nil {
+			t.Error("Expected an error for a missing vertex, got nil")
+		}
+	})
+
+	t.Run("Remove an edge", func(t *testing.T) {
+		t.Parallel()
+
+		g := newGraph()
+		g.RemoveEdge("a", "b")
+
+		if degree, _ := g.OutDegree("a"); degree != 1 {
+			t.Errorf("Expected out-degree of a to be 1, got %d", degree)
+		}
+
+		if in, _ := g.InNeighbours("b"); !reflect.DeepEqual(in, []string{"c"}) {
+			t.Errorf("Expected in-neighbours of b to be %v, got %v", []string{"c"}, in)
+		}
+	})
+
+	t.Run("Remove a vertex", func(t *testing.T) {
+		t.Parallel()
+
+		g := newGraph()
+		g.RemoveVertex("c")
+
+		if in, _ := g.InNeighbours("b"); !reflect.DeepEqual(in, []string{"a"}) {
+			t.Errorf("Expected in-neighbours of b to be %v, got %v", []string{"a"}, in)
+		}
+	})
+
+	t.Run("Undirected degrees", func(t *testing.T) {
+		t.Parallel()
+
+		g := NewGraph()
+		g.AddVertex("a")
+		g.AddVertex("b")
+		g.AddEdge("a", "b", 1)
+
+		in, _ := g.InDegree("a")
+		out, _ := g.OutDegree("a")
+		if in != 1 || out != 1 {
+			t.Errorf("Expected in- and out-degree of a to be 1, got %d and %d", in, out)
+		}
+	})
+}
+
+func TestGenericGraph(t *testing.T) {
+	t.Parallel()
+
+	type city struct {
+		name    string
+		country string
+	}
+
+	type road struct {
+		name string
+		km   float64
+	}
+
+	newGraph := func() *Graph[int, city, road] {
+		g := NewGraphOf[int, city, road](false, func(r road) float64 { return r.km })
+
+		g.AddVertex(1)
+		g.AddVertex(2)
+		g.AddVertex(3)
+		g.SetVertexValue(1, city{"nairobi", "ke"})
+		g.SetVertexValue(2, city{"nakuru", "ke"})
+		g.SetVertexValue(3, city{"kisumu", "ke"})
+
+		g.AddEdge(1, 2, road{"A104", 157})
+		g.AddEdge(2, 3, road{"B1", 185})
+		g.AddEdge(1, 3, road{"A104/B1", 346})
+		return g
+	}
+
+	t.Run("Vertex values", func(t *testing.T) {
+		t.Parallel()
+
+		g := newGraph()
+
+		value, err := g.VertexValue(2)
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if value.name != "nakuru" {
+			t.Errorf("Expected vertex 2 to be nakuru, got %s", value.name)
+		}
+
+		if _, err := g.VertexValue(4); err == nil {
+			t.Error("Expected an error for a missing vertex, got nil")
+		}
+		if err := g.SetVertexValue(4, city{}); err == nil {
+			t.Error("Expected an error for a missing vertex, got nil")
+		}
+	})
+
+	t.Run("Edge data and weights", func(t *testing.T) {
+		t.Parallel()
+
+		g := newGraph()
+
+		data, err := g.Edge(2, 1)
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if data.name != "A104" {
+			t.Errorf("Expected edge 2-1 to be A104, got %s", data.name)
+		}
+
+		if weight, _ := g.Weight(1, 2); weight != 157 {
+			t.Errorf("Expected weight of edge 1-2 to be 157, got %f", weight)
+		}
+
+		if _, err := g.Edge(3, 4); err == nil {
+			t.Error("Expected an error for a missing edge, got nil")
+		}
+	})
+
+	t.Run("Shortest path", func(t *testing.T) {
+		t.Parallel()
+
+		g := newGraph()
+		distances := g.ShortestPath(g.GetVertex(1))
+
+		if distances[3] != 342 {
+			t.Errorf("Expected distance from 1 to 3 to be 342, got %f", distances[3])
+		}
+	})
+
+	t.Run("Unweighted edges", func(t *testing.T) {
+		t.Parallel()
+
+		g := NewGraphOf[string, struct{}, struct{}](true, nil)
+		g.AddVertex("a")
+		g.AddVertex("b")
+		g.AddEdge("a", "b", struct{}{})
+
+		if weight, _ := g.Weight("a", "b"); weight != 1 {
+			t.Errorf("Expected weight of an unweighted edge to be 1, got %f", weight)
+		}
+	})
+}
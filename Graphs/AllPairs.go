@@ -0,0 +1,130 @@
+package Graphs
+
+import (
+	"errors"
+	"math"
+)
+
+// The result of an all-pairs shortest path search
+type AllPaths[K comparable] struct {
+	Distances    map[K]map[K]float64 // Distances[src][dst], +Inf if dst can't be reached from src
+	Predecessors map[K]map[K]K       // Predecessors[src][v] is the vertex before v on the path from src
+}
+
+// Returns the keys of the vertices on the shortest path from src to dst
+func (a *AllPaths[K]) PathBetween(src, dst K) ([]K, error) {
+	distances, ok := a.Distances[src]
+	if !ok {
+		return nil, errors.New("source vertex does not exist")
+	}
+
+	paths := &Paths[K]{Source: src, Distances: distances, Predecessors: a.Predecessors[src]}
+	return paths.PathTo(dst)
+}
+
+// Compute the shortest paths between every pair of vertices using the
+// Floyd-Warshall algorithm. Runs in O(V^3), which suits dense graphs.
+// Returns a *NegativeCycleError if the graph has a negative cycle
+func (g *Graph[K, V, E]) FloydWarshall() (*AllPaths[K], error) {
+	vertices := g.sortedVertices()
+	n := len(vertices)
+
+	// dist[i][j] is the shortest distance from vertex i to vertex j found so far
+	// and pred[i][j] the index of the vertex before j on that path, -1 if there is none
+	dist := make([][]float64, n)
+	pred := make([][]int, n)
+	for i, v := range vertices {
+		dist[i] = make([]float64, n)
+		pred[i] = make([]int, n)
+		for j := range dist[i] {
+			dist[i][j] = math.Inf(1)
+			pred[i][j] = -1
+		}
+		dist[i][i] = 0
+
+		for j, u := range vertices {
+			if neighbour, ok := v.neighbours[u.key]; ok && g.weight(neighbour.data) < dist[i][j] {
+				dist[i][j] = g.weight(neighbour.data)
+				pred[i][j] = i
+			}
+		}
+	}
+
+	// allow paths through vertex k, one vertex at a time
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if math.IsInf(dist[i][k], 1) {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if through := dist[i][k] + dist[k][j]; through < dist[i][j] {
+					dist[i][j] = through
+					pred[i][j] = pred[k][j]
+				}
+			}
+		}
+	}
+
+	result := &AllPaths[K]{
+		Distances:    make(map[K]map[K]float64, n),
+		Predecessors: make(map[K]map[K]K, n),
+	}
+
+	for i, v := range vertices {
+		result.Distances[v.key] = make(map[K]float64, n)
+		result.Predecessors[v.key] = make(map[K]K)
+
+		for j, u := range vertices {
+			result.Distances[v.key][u.key] = dist[i][j]
+			if pred[i][j] >= 0 {
+				result.Predecessors[v.key][u.key] = vertices[pred[i][j]].key
+			}
+		}
+	}
+
+	// a vertex with a negative distance to itself is on a negative cycle
+	for i, v := range vertices {
+		if dist[i][i] < 0 {
+			return nil, &NegativeCycleError[K]{Cycle: findCycle(result.Predecessors[v.key], v.key, n)}
+		}
+	}
+
+	return result, nil
+}
+
+// Compute the shortest paths between every pair of vertices using Johnson's
+// algorithm. Bellman-Ford finds a potential for every vertex that makes all
+// the weights non-negative, then Dijkstra runs from every vertex.
+// Runs in O(VE log V), which suits sparse graphs.
+// Returns a *NegativeCycleError if the graph has a negative cycle
+func (g *Graph[K, V, E]) Johnson() (*AllPaths[K], error) {
+	// starting every vertex at 0 is the same as adding a new vertex with an
+	// edge of weight 0 to every other vertex and searching from it
+	potentials := make(map[K]float64, len(g.vertices))
+	for key := range g.vertices {
+		potentials[key] = 0
+	}
+
+	if err := g.bellmanFord(potentials, make(map[K]K), len(g.vertices)); err != nil {
+		return nil, err
+	}
+
+	result := &AllPaths[K]{
+		Distances:    make(map[K]map[K]float64, len(g.vertices)),
+		Predecessors: make(map[K]map[K]K, len(g.vertices)),
+	}
+
+	for key, src := range g.vertices {
+		distances, predecessors := g.dijkstra(src, nil, potentials)
+
+		// undo the reweighting
+		for dst, distance := range distances {
+			distances[dst] = distance - potentials[key] + potentials[dst]
+		}
+
+		result.Distances[key] = distances
+		result.Predecessors[key] = predecessors
+	}
+
+	return result, nil
+}
@@ -0,0 +1,132 @@
+package Graphs
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAllPairs(t *testing.T) {
+	t.Parallel()
+
+	algorithms := map[string]func(g *StringGraph) (*AllPaths[string], error){
+		"FloydWarshall": (*StringGraph).FloydWarshall,
+		"Johnson":       (*StringGraph).Johnson,
+	}
+
+	for name, algorithm := range algorithms {
+		name, algorithm := name, algorithm
+
+		t.Run(name+" matches Dijkstra from every vertex", func(t *testing.T) {
+			t.Parallel()
+
+			g := newTestGraph(NewGraph(), eastAfrica)
+
+			all, err := algorithm(g)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			for _, src := range eastAfrica.vertices {
+				paths, _ := g.ShortestPaths(src)
+				if !reflect.DeepEqual(all.Distances[src], paths.Distances) {
+					t.Errorf("Expected distances from %s to be %v, got %v", src, paths.Distances, all.Distances[src])
+				}
+			}
+
+			path, err := all.PathBetween("kitale", "mombasa")
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			expected := []string{"kitale", "eldoret", "nakuru", "nairobi", "mombasa"}
+			if !reflect.DeepEqual(path, expected) {
+				t.Errorf("Expected path to be %v, got %v", expected, path)
+			}
+		})
+
+		t.Run(name+" with negative weights", func(t *testing.T) {
+			t.Parallel()
+
+			g := newTestGraph(NewDirectedGraph(), TestCase{
+				vertices: []string{"s", "a", "b", "c", "d"},
+				edges: []edge{
+					{"s", "a", 4}, {"s", "b", 5},
+					{"a", "c", 3}, {"b", "a", -3},
+					{"c", "d", 2}, {"b", "d", 6},
+				},
+			})
+			g.AddVertex("unreachable")
+
+			all, err := algorithm(g)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			for _, src := range []string{"s", "a", "b", "c", "d", "unreachable"} {
+				paths, _ := g.BellmanFord(src)
+				if !reflect.DeepEqual(all.Distances[src], paths.Distances) {
+					t.Errorf("Expected distances from %s to be %v, got %v", src, paths.Distances, all.Distances[src])
+				}
+			}
+
+			path, _ := all.PathBetween("s", "d")
+			if !reflect.DeepEqual(path, []string{"s", "b", "a", "c", "d"}) {
+				t.Errorf("Expected path from s to d to be %v, got %v", []string{"s", "b", "a", "c", "d"}, path)
+			}
+
+			path, _ = all.PathBetween("b", "b")
+			if !reflect.DeepEqual(path, []string{"b"}) {
+				t.Errorf("Expected path from b to itself to be %v, got %v", []string{"b"}, path)
+			}
+		})
+
+		t.Run(name+" with unreachable and missing vertices", func(t *testing.T) {
+			t.Parallel()
+
+			g := newTestGraph(NewDirectedGraph(), TestCase{
+				vertices: []string{"a", "b"},
+				edges:    []edge{{"a", "b", 1}},
+			})
+
+			all, _ := algorithm(g)
+
+			if !math.IsInf(all.Distances["b"]["a"], 1) {
+				t.Errorf("Expected b - a to be +Inf, got %f", all.Distances["b"]["a"])
+			}
+			if _, err := all.PathBetween("b", "a"); err == nil {
+				t.Error("Expected an error for an unreachable vertex, got nil")
+			}
+			if _, err := all.PathBetween("c", "a"); err == nil {
+				t.Error("Expected an error for a missing source, got nil")
+			}
+			if _, err := all.PathBetween("a", "c"); err == nil {
+				t.Error("Expected an error for a missing destination, got nil")
+			}
+		})
+
+		t.Run(name+" with a negative cycle", func(t *testing.T) {
+			t.Parallel()
+
+			// the cycle can't be reached from s, but it still has no shortest paths
+			g := newTestGraph(NewDirectedGraph(), TestCase{
+				vertices: []string{"s", "a", "b", "c"},
+				edges: []edge{
+					{"a", "b", 1}, {"b", "c", -3}, {"c", "a", 1},
+					{"a", "s", 1},
+				},
+			})
+
+			_, err := algorithm(g)
+
+			var cycleErr *NegativeCycleError[string]
+			if !errors.As(err, &cycleErr) {
+				t.Fatalf("Expected a NegativeCycleError, got %v", err)
+			}
+			if len(cycleErr.Cycle) != 3 {
+				t.Errorf("Expected cycle to be a-b-c, got %v", cycleErr.Cycle)
+			}
+		})
+	}
+}
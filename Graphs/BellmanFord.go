@@ -0,0 +1,153 @@
+package Graphs
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Returned when a negative cycle can be reached from the source, since the
+// vertices on it have no shortest path. In an undirected graph every negative
+// edge is a negative cycle
+type NegativeCycleError[K comparable] struct {
+	Cycle []K // the vertices on the cycle, in the order they are visited
+}
+
+func (e *NegativeCycleError[K]) Error() string {
+	return fmt.Sprintf("graph has a negative cycle: %v", e.Cycle)
+}
+
+// Compute the shortest paths from a source to all other vertices using the
+// Bellman-Ford algorithm, which allows negative weights.
+// Returns a *NegativeCycleError if a negative cycle can be reached from the source
+func (g *Graph[K, V, E]) BellmanFord(src K) (*Paths[K], error) {
+	if g.GetVertex(src) == nil {
+		return nil, errors.New("source vertex does not exist")
+	}
+
+	distances, predecessors := g.initialDistances(src)
+
+	// after |V|-1 rounds of relaxing every edge, every shortest path is known
+	if err := g.bellmanFord(distances, predecessors, len(g.vertices)-1); err != nil {
+		return nil, err
+	}
+
+	return &Paths[K]{Source: src, Distances: distances, Predecessors: predecessors}, nil
+}
+
+// Compute the shortest paths from a source to all other vertices using the
+// Shortest Path Faster Algorithm, a queue-based Bellman-Ford that only
+// relaxes the edges of vertices whose distance changed.
+// Returns a *NegativeCycleError if a negative cycle can be reached from the source
+func (g *Graph[K, V, E]) SPFA(src K) (*Paths[K], error) {
+	from := g.GetVertex(src)
+	if from == nil {
+		return nil, errors.New("source vertex does not exist")
+	}
+
+	distances, predecessors := g.initialDistances(src)
+
+	// number of edges on the current shortest path to each vertex
+	lengths := map[K]int{src: 0}
+
+	queue := []*vertex[K, V, E]{from}
+	queued := map[K]bool{src: true}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		queued[current.key] = false
+
+		for _, n := range sortedNeighbours(current.neighbours) {
+			through := distances[current.key] + g.weight(n.data)
+			if through >= distances[n.vertex.key] {
+				continue
+			}
+
+			distances[n.vertex.key] = through
+			predecessors[n.vertex.key] = current.key
+
+			// a shortest path can't have more than |V|-1 edges. The predecessors may
+			// not form the cycle yet, so let Bellman-Ford find it
+			lengths[n.vertex.key] = lengths[current.key] + 1
+			if lengths[n.vertex.key] >= len(g.vertices) {
+				_, err := g.BellmanFord(src)
+				return nil, err
+			}
+
+			if !queued[n.vertex.key] {
+				queue = append(queue, n.vertex)
+				queued[n.vertex.key] = true
+			}
+		}
+	}
+
+	return &Paths[K]{Source: src, Distances: distances, Predecessors: predecessors}, nil
+}
+
+// Relaxes every edge for the given number of rounds, or until nothing changes.
+// Returns a *NegativeCycleError if an edge can still be relaxed afterwards
+func (g *Graph[K, V, E]) bellmanFord(distances map[K]float64, predecessors map[K]K, rounds int) error {
+	vertices := g.sortedVertices()
+
+	for round := 0; round < rounds; round++ {
+		relaxed := false
+
+		for _, v := range vertices {
+			for _, n := range sortedNeighbours(v.neighbours) {
+				if through := distances[v.key] + g.weight(n.data); through < distances[n.vertex.key] {
+					distances[n.vertex.key] = through
+					predecessors[n.vertex.key] = v.key
+					relaxed = true
+				}
+			}
+		}
+
+		if !relaxed {
+			return nil
+		}
+	}
+
+	// an edge that can still be relaxed lies on, or leads from, a negative cycle
+	for _, v := range vertices {
+		for _, n := range sortedNeighbours(v.neighbours) {
+			if distances[v.key]+g.weight(n.data) < distances[n.vertex.key] {
+				predecessors[n.vertex.key] = v.key
+				return &NegativeCycleError[K]{Cycle: findCycle(predecessors, n.vertex.key, len(vertices))}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Returns the distances and predecessors a single-source search starts with
+func (g *Graph[K, V, E]) initialDistances(src K) (map[K]float64, map[K]K) {
+	distances := make(map[K]float64, len(g.vertices))
+	for key := range g.vertices {
+		distances[key] = math.Inf(1)
+	}
+	distances[src] = 0
+
+	return distances, make(map[K]K)
+}
+
+// Returns the cycle in the predecessor graph that start leads back to.
+// Walking back n times from start is guaranteed to end up on the cycle
+func findCycle[K comparable](predecessors map[K]K, start K, n int) []K {
+	current := start
+	for i := 0; i < n; i++ {
+		current = predecessors[current]
+	}
+
+	// walk around the cycle once, then reverse it into the order it is visited
+	cycle := []K{current}
+	for next := predecessors[current]; next != current; next = predecessors[next] {
+		cycle = append(cycle, next)
+	}
+
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return cycle
+}
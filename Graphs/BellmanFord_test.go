@@ -0,0 +1,141 @@
+package Graphs
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestBellmanFord(t *testing.T) {
+	t.Parallel()
+
+	algorithms := map[string]func(g *StringGraph, src string) (*Paths[string], error){
+		"BellmanFord": (*StringGraph).BellmanFord,
+		"SPFA":        (*StringGraph).SPFA,
+	}
+
+	for name, algorithm := range algorithms {
+		name, algorithm := name, algorithm
+
+		t.Run(name+" matches Dijkstra on positive weights", func(t *testing.T) {
+			t.Parallel()
+
+			g := newTestGraph(NewGraph(), eastAfrica)
+
+			paths, err := algorithm(g, "nairobi")
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			for key, value := range paths.Distances {
+				if value != eastAfrica.expected[key] {
+					t.Errorf("Expected nairobi - %s to be %f, got %f", key, eastAfrica.expected[key], value)
+				}
+			}
+		})
+
+		t.Run(name+" with negative weights", func(t *testing.T) {
+			t.Parallel()
+
+			g := newTestGraph(NewDirectedGraph(), TestCase{
+				vertices: []string{"s", "a", "b", "c", "d"},
+				edges: []edge{
+					{"s", "a", 4}, {"s", "b", 5},
+					{"a", "c", 3}, {"b", "a", -3},
+					{"c", "d", 2}, {"b", "d", 6},
+				},
+			})
+			g.AddVertex("unreachable")
+
+			paths, err := algorithm(g, "s")
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			expected := map[string]float64{"s": 0, "a": 2, "b": 5, "c": 5, "d": 7, "unreachable": math.Inf(1)}
+			if !reflect.DeepEqual(paths.Distances, expected) {
+				t.Errorf("Expected distances to be %v, got %v", expected, paths.Distances)
+			}
+
+			path, _ := paths.PathTo("d")
+			if !reflect.DeepEqual(path, []string{"s", "b", "a", "c", "d"}) {
+				t.Errorf("Expected path to d to be %v, got %v", []string{"s", "b", "a", "c", "d"}, path)
+			}
+		})
+
+		t.Run(name+" with a negative cycle", func(t *testing.T) {
+			t.Parallel()
+
+			g := newTestGraph(NewDirectedGraph(), TestCase{
+				vertices: []string{"s", "a", "b", "c", "d"},
+				edges: []edge{
+					{"s", "a", 1}, {"a", "b", 1},
+					{"b", "c", -3}, {"c", "a", 1},
+					{"c", "d", 1},
+				},
+			})
+
+			_, err := algorithm(g, "s")
+
+			var cycleErr *NegativeCycleError[string]
+			if !errors.As(err, &cycleErr) {
+				t.Fatalf("Expected a NegativeCycleError, got %v", err)
+			}
+
+			// the cycle can start at any of its vertices
+			cycle := append(cycleErr.Cycle, cycleErr.Cycle...)
+			found := false
+			for i := 0; i < len(cycleErr.Cycle); i++ {
+				if reflect.DeepEqual(cycle[i:i+3], []string{"a", "b", "c"}) {
+					found = true
+				}
+			}
+			if len(cycleErr.Cycle) != 3 || !found {
+				t.Errorf("Expected cycle to be a-b-c, got %v", cycleErr.Cycle)
+			}
+		})
+
+		t.Run(name+" with a negative undirected edge", func(t *testing.T) {
+			t.Parallel()
+
+			g := newTestGraph(NewGraph(), TestCase{
+				vertices: []string{"a", "b"},
+				edges:    []edge{{"a", "b", -1}},
+			})
+
+			var cycleErr *NegativeCycleError[string]
+			if _, err := algorithm(g, "a"); !errors.As(err, &cycleErr) {
+				t.Errorf("Expected a NegativeCycleError, got %v", err)
+			}
+		})
+	}
+
+	t.Run("Dijkstra matches on fractional weights", func(t *testing.T) {
+		t.Parallel()
+
+		// truncating priorities would queue d (1.9) and b (1.1) both as 1 and
+		// settle d before the shorter route through b is found
+		g := NewDirectedGraph()
+		for _, key := range []string{"a", "b", "c", "d", "x"} {
+			g.AddVertex(key)
+		}
+		g.AddEdge("a", "c", 0.2)
+		g.AddEdge("c", "d", 1.7)
+		g.AddEdge("c", "x", 0.3)
+		g.AddEdge("x", "b", 0.6)
+		g.AddEdge("b", "d", 0.1)
+
+		distances := g.ShortestPath(g.GetVertex("a"))
+		if math.Abs(distances["d"]-1.2) > 1e-9 {
+			t.Errorf("Expected a - d to be 1.2, got %f", distances["d"])
+		}
+
+		paths, _ := g.BellmanFord("a")
+		for key, value := range distances {
+			if math.Abs(value-paths.Distances[key]) > 1e-9 {
+				t.Errorf("Expected a - %s to be %f, got %f", key, paths.Distances[key], value)
+			}
+		}
+	})
+}
@@ -1,16 +1,92 @@
 package Graphs
 
 import (
+	"errors"
 	"math"
 
 	"github.com/AustinMusiku/dataStructures/Heap"
 )
 
+// The result of a single-source shortest path search
+type Paths[K comparable] struct {
+	Source       K
+	Distances    map[K]float64 // +Inf for vertices that can't be reached
+	Predecessors map[K]K       // the vertex before each reachable vertex on its shortest path
+}
+
 // Compute the shortest path from a source to all other vertices using Dijkstra's algorithm.
 // Returns a map of vertices and their distances from the source.
-func (g *Graph) ShortestPath(src *vertex) map[string]float64 {
-	distances := make(map[string]float64)
-	visited := make(map[string]bool)
+func (g *Graph[K, V, E]) ShortestPath(src *vertex[K, V, E]) map[K]float64 {
+	distances, _ := g.dijkstra(src, nil, nil)
+	return distances
+}
+
+// Compute the shortest paths from a source to all other vertices using Dijkstra's algorithm.
+// Unlike ShortestPath, the result can also reconstruct the paths
+func (g *Graph[K, V, E]) ShortestPaths(src K) (*Paths[K], error) {
+	from := g.GetVertex(src)
+	if from == nil {
+		return nil, errors.New("source vertex does not exist")
+	}
+
+	distances, predecessors := g.dijkstra(from, nil, nil)
+	return &Paths[K]{Source: src, Distances: distances, Predecessors: predecessors}, nil
+}
+
+// Compute the shortest path between two vertices using Dijkstra's algorithm.
+// The search stops as soon as the destination's distance is final.
+// Returns the keys of the vertices on the path, from src to dst, and its length
+func (g *Graph[K, V, E]) ShortestPathBetween(src, dst K) ([]K, float64, error) {
+	from := g.GetVertex(src)
+	if from == nil {
+		return nil, 0, errors.New("source vertex does not exist")
+	}
+
+	to := g.GetVertex(dst)
+	if to == nil {
+		return nil, 0, errors.New("destination vertex does not exist")
+	}
+
+	distances, predecessors := g.dijkstra(from, to, nil)
+	paths := &Paths[K]{Source: src, Distances: distances, Predecessors: predecessors}
+
+	path, err := paths.PathTo(dst)
+	if err != nil {
+		return nil, 0, err
+	}
+	return path, distances[dst], nil
+}
+
+// Returns the keys of the vertices on the shortest path from the source to dst
+func (p *Paths[K]) PathTo(dst K) ([]K, error) {
+	distance, ok := p.Distances[dst]
+	if !ok {
+		return nil, errors.New("destination vertex does not exist")
+	}
+	if math.IsInf(distance, 1) {
+		return nil, errors.New("destination vertex is not reachable")
+	}
+
+	// walk back from the destination to the source, then reverse
+	path := []K{dst}
+	for current := dst; current != p.Source; {
+		current = p.Predecessors[current]
+		path = append(path, current)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, nil
+}
+
+// Dijkstra's algorithm from src. If dst is not nil, stops once its distance is final.
+// If potentials is not nil, every edge u-v is reweighted to w + p(u) - p(v).
+// Returns the distances and predecessors of every vertex
+func (g *Graph[K, V, E]) dijkstra(src, dst *vertex[K, V, E], potentials map[K]float64) (map[K]float64, map[K]K) {
+	distances := make(map[K]float64)
+	predecessors := make(map[K]K)
+	visited := make(map[K]bool)
 
 	// initialise all distances to a ridiculously high value (+Infinity)
 	// except the src which will have 0
@@ -22,7 +98,7 @@ func (g *Graph) ShortestPath(src *vertex) map[string]float64 {
 	}
 
 	// Use the pQueue to retrieve the element with the next shortest distance
-	priorityQueue := Heap.NewHeap[*vertex]("min")
+	priorityQueue := Heap.NewHeapOf[*vertex[K, V, E], float64]("min")
 
 	// start with the src in the pQueue
 	priorityQueue.Insert(src, 0)
@@ -30,22 +106,38 @@ func (g *Graph) ShortestPath(src *vertex) map[string]float64 {
 	for priorityQueue.Size() > 0 {
 		current := priorityQueue.Remove()
 
+		// skip stale entries for vertices that were reached more cheaply
+		if visited[current.Value.key] {
+			continue
+		}
+		visited[current.Value.key] = true
+
+		// the distance to the destination can't get any shorter
+		if current.Value == dst {
+			break
+		}
+
 		// cycle through all the current vertex's neighbours
 		for key, neighbour := range current.Value.neighbours {
 
 			if _, ok := visited[key]; !ok {
 				// compare distance already in distances map to
 				// distance through the current vertex
-				distanceThroughCurrent := distances[current.Value.key] + neighbour.weight
+				weight := g.weight(neighbour.data)
+				if potentials != nil {
+					weight += potentials[current.Value.key] - potentials[key]
+				}
+
+				distanceThroughCurrent := distances[current.Value.key] + weight
 				if distances[key] > distanceThroughCurrent {
 					distances[key] = distanceThroughCurrent
-					priorityQueue.Insert(neighbour.vertex, int(distanceThroughCurrent))
+					predecessors[key] = current.Value.key
+
+					priorityQueue.Insert(neighbour.vertex, distanceThroughCurrent)
 				}
 			}
 		}
-
-		visited[current.Value.key] = true
 	}
 
-	return distances
+	return distances, predecessors
 }
@@ -1,6 +1,10 @@
 package Graphs
 
-import "testing"
+import (
+	"math"
+	"reflect"
+	"testing"
+)
 
 type edge struct {
 	src    string
@@ -15,62 +19,78 @@ type TestCase struct {
 	expected map[string]float64
 }
 
+// Road distances between towns in East Africa, in km
+var eastAfrica = TestCase{
+	vertices: []string{
+		"kampala", "jinja", "kisumu", "kitale", "eldoret", "nakuru", "nyeri", "nairobi", "mombasa", "arusha", "tanga", "dodoma", "morogoro", "dar-es-salam", "lindi",
+	},
+	edges: []edge{
+		{"kampala", "jinja", 80},
+		{"jinja", "kisumu", 230},
+		{"kisumu", "kitale", 169},
+		{"kisumu", "nakuru", 185},
+		{"kisumu", "nairobi", 346},
+		{"kitale", "eldoret", 71},
+		{"eldoret", "nakuru", 156},
+		{"nakuru", "nyeri", 163},
+		{"nakuru", "nairobi", 157},
+		{"nairobi", "nyeri", 150},
+		{"nairobi", "mombasa", 488},
+		{"nairobi", "arusha", 269},
+		{"mombasa", "arusha", 389},
+		{"mombasa", "tanga", 203},
+		{"arusha", "tanga", 436},
+		{"arusha", "dodoma", 413},
+		{"dodoma", "morogoro", 264},
+		{"tanga", "morogoro", 333},
+		{"tanga", "dar-es-salam", 356},
+		{"dar-es-salam", "morogoro", 194},
+		{"dar-es-salam", "lindi", 457},
+	},
+	source: "nairobi",
+	expected: map[string]float64{
+		// direct distances
+		"nairobi": 0,
+		"nyeri":   150,
+		"nakuru":  157,
+		"kisumu":  342,
+		"mombasa": 488,
+		"arusha":  269,
+		// indirect distances
+		// ke
+		"eldoret": 313,
+		"kitale":  384,
+		// ug
+		"jinja":   572,
+		"kampala": 652,
+		// tz
+		"tanga":        691,
+		"dodoma":       682,
+		"morogoro":     946,
+		"dar-es-salam": 1047,
+		"lindi":        1504,
+	},
+}
+
+// Builds a graph from the vertices and edges of a test case
+func newTestGraph(g *StringGraph, testCase TestCase) *StringGraph {
+	for _, vertex := range testCase.vertices {
+		g.AddVertex(vertex)
+	}
+
+	for _, edge := range testCase.edges {
+		g.AddEdge(edge.src, edge.dest, float64(edge.weight))
+	}
+
+	return g
+}
+
 func TestShortestPath(t *testing.T) {
 
 	t.Run("Shortest path from source to all other vertices", func(t *testing.T) {
 		t.Parallel()
 
-		testCase := TestCase{
-			vertices: []string{
-				"kampala", "jinja", "kisumu", "kitale", "eldoret", "nakuru", "nyeri", "nairobi", "mombasa", "arusha", "tanga", "dodoma", "morogoro", "dar-es-salam", "lindi",
-			},
-			edges: []edge{
-				{"kampala", "jinja", 80},
-				{"jinja", "kisumu", 230},
-				{"kisumu", "kitale", 169},
-				{"kisumu", "nakuru", 185},
-				{"kisumu", "nairobi", 346},
-				{"kitale", "eldoret", 71},
-				{"eldoret", "nakuru", 156},
-				{"nakuru", "nyeri", 163},
-				{"nakuru", "nairobi", 157},
-				{"nairobi", "nyeri", 150},
-				{"nairobi", "mombasa", 488},
-				{"nairobi", "arusha", 269},
-				{"mombasa", "arusha", 389},
-				{"mombasa", "tanga", 203},
-				{"arusha", "tanga", 436},
-				{"arusha", "dodoma", 413},
-				{"dodoma", "morogoro", 264},
-				{"tanga", "morogoro", 333},
-				{"tanga", "dar-es-salam", 356},
-				{"dar-es-salam", "morogoro", 194},
-				{"dar-es-salam", "lindi", 457},
-			},
-			source: "nairobi",
-			expected: map[string]float64{
-				// direct distances
-				"nairobi": 0,
-				"nyeri":   150,
-				"nakuru":  157,
-				"kisumu":  342,
-				"mombasa": 488,
-				"arusha":  269,
-				// indirect distances
-				// ke
-				"eldoret": 313,
-				"kitale":  384,
-				// ug
-				"jinja":   572,
-				"kampala": 652,
-				// tz
-				"tanga":        691,
-				"dodoma":       682,
-				"morogoro":     946,
-				"dar-es-salam": 1047,
-				"lindi":        1504,
-			},
-		}
+		testCase := eastAfrica
 
 		g := NewGraph()
 
@@ -91,3 +111,131 @@ func TestShortestPath(t *testing.T) {
 		}
 	})
 }
+
+func TestShortestPaths(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Paths from source to all other vertices", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewGraph(), eastAfrica)
+
+		paths, err := g.ShortestPaths("nairobi")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		for key, value := range paths.Distances {
+			if value != eastAfrica.expected[key] {
+				t.Errorf("Expected nairobi - %s to be %f, got %f", key, eastAfrica.expected[key], value)
+			}
+		}
+
+		if paths.Predecessors["lindi"] != "dar-es-salam" {
+			t.Errorf("Expected lindi to be reached from dar-es-salam, got %s", paths.Predecessors["lindi"])
+		}
+
+		path, err := paths.PathTo("kampala")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		expected := []string{"nairobi", "nakuru", "kisumu", "jinja", "kampala"}
+		if !reflect.DeepEqual(path, expected) {
+			t.Errorf("Expected path to kampala to be %v, got %v", expected, path)
+		}
+
+		if path, _ := paths.PathTo("nairobi"); !reflect.DeepEqual(path, []string{"nairobi"}) {
+			t.Errorf("Expected path to the source to be %v, got %v", []string{"nairobi"}, path)
+		}
+	})
+
+	t.Run("Unreachable and missing vertices", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewGraph(), eastAfrica)
+		g.AddVertex("kigali")
+
+		paths, _ := g.ShortestPaths("nairobi")
+
+		if _, err := paths.PathTo("kigali"); err == nil {
+			t.Error("Expected an error for an unreachable vertex, got nil")
+		}
+		if _, err := paths.PathTo("addis ababa"); err == nil {
+			t.Error("Expected an error for a missing vertex, got nil")
+		}
+		if _, err := g.ShortestPaths("addis ababa"); err == nil {
+			t.Error("Expected an error for a missing source, got nil")
+		}
+	})
+
+	t.Run("Path between two vertices", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewGraph(), eastAfrica)
+
+		path, distance, err := g.ShortestPathBetween("kitale", "mombasa")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		expected := []string{"kitale", "eldoret", "nakuru", "nairobi", "mombasa"}
+		if !reflect.DeepEqual(path, expected) {
+			t.Errorf("Expected path to be %v, got %v", expected, path)
+		}
+		if distance != 872 {
+			t.Errorf("Expected distance to be 872, got %f", distance)
+		}
+
+		if _, _, err := g.ShortestPathBetween("kitale", "kigali"); err == nil {
+			t.Error("Expected an error for a missing destination, got nil")
+		}
+	})
+
+	t.Run("Fractional weights", func(t *testing.T) {
+		t.Parallel()
+
+		// s-a-t is shorter when distances are truncated to whole numbers. Map order
+		// decides which route is settled first, so run the search several times
+		g := NewDirectedGraph()
+		for _, key := range []string{"s", "a", "b", "c", "t"} {
+			g.AddVertex(key)
+		}
+		g.AddEdge("s", "a", 0.1)
+		g.AddEdge("a", "t", 1.0)
+		g.AddEdge("s", "c", 0.2)
+		g.AddEdge("c", "b", 0.85)
+		g.AddEdge("b", "t", 0.01)
+
+		for i := 0; i < 20; i++ {
+			path, distance, _ := g.ShortestPathBetween("s", "t")
+			if !reflect.DeepEqual(path, []string{"s", "c", "b", "t"}) || math.Abs(distance-1.06) > 1e-9 {
+				t.Fatalf("Expected s-c-b-t with distance 1.06, got %v with distance %f", path, distance)
+			}
+
+			paths, _ := g.ShortestPaths("s")
+			if math.Abs(paths.Distances["t"]-1.06) > 1e-9 {
+				t.Fatalf("Expected s - t to be 1.06, got %f", paths.Distances["t"])
+			}
+		}
+	})
+
+	t.Run("Directed graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewDirectedGraph(), TestCase{
+			vertices: []string{"a", "b", "c"},
+			edges:    []edge{{"a", "b", 1}, {"b", "c", 1}, {"c", "a", 1}},
+		})
+
+		path, distance, _ := g.ShortestPathBetween("a", "c")
+		if !reflect.DeepEqual(path, []string{"a", "b", "c"}) || distance != 2 {
+			t.Errorf("Expected a-b-c with distance 2, got %v with distance %f", path, distance)
+		}
+
+		path, distance, _ = g.ShortestPathBetween("c", "b")
+		if !reflect.DeepEqual(path, []string{"c", "a", "b"}) || distance != 2 {
+			t.Errorf("Expected c-a-b with distance 2, got %v with distance %f", path, distance)
+		}
+	})
+}
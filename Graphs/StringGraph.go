@@ -0,0 +1,23 @@
+package Graphs
+
+// The original graph API: string keys, any vertex value and float64 edge weights
+type StringGraph = Graph[string, any, float64]
+
+// initialize a new undirected graph
+func NewGraph() *StringGraph {
+	return NewGraphOf[string, any, float64](false, identity)
+}
+
+// initialize a new directed graph. Edges only go from their source to their destination
+func NewDirectedGraph() *StringGraph {
+	return NewGraphOf[string, any, float64](true, identity)
+}
+
+// initializes a new node and sets its key
+func NewVertex(key string) *vertex[string, any, float64] {
+	return newVertex[string, any, float64](key)
+}
+
+func identity(weight float64) float64 {
+	return weight
+}
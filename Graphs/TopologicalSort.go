@@ -0,0 +1,208 @@
+package Graphs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/AustinMusiku/dataStructures/Heap"
+)
+
+// Returned when the vertices of a directed graph can't be ordered
+// because some of them depend on each other
+type CycleError[K comparable] struct {
+	Cycle []K // the vertices on the cycle, in the order they are visited
+}
+
+func (e *CycleError[K]) Error() string {
+	return fmt.Sprintf("graph has a cycle: %v", e.Cycle)
+}
+
+// Order the vertices of a directed graph so that every edge goes from an earlier
+// vertex to a later one, using Kahn's algorithm. Whenever several vertices are
+// free to go next, the one added to the graph first goes first.
+// Returns a *CycleError if the graph has a cycle
+func (g *Graph[K, V, E]) TopologicalSort() ([]K, error) {
+	layers, err := g.kahn(false)
+	if err != nil {
+		return nil, err
+	}
+
+	order := []K{}
+	if len(layers) > 0 {
+		order = layers[0]
+	}
+	return order, nil
+}
+
+// Order the vertices of a directed graph so that every edge goes from an earlier
+// vertex to a later one, using a depth-first search. Each vertex comes before
+// everything it can reach, so it is placed once all of those have been finished.
+// Returns a *CycleError if the graph has a cycle
+func (g *Graph[K, V, E]) TopologicalSortDFS() ([]K, error) {
+	if !g.directed {
+		return nil, errors.New("graph is undirected. Can't sort its vertices")
+	}
+
+	order := make([]K, len(g.vertices))
+	next := len(order) - 1
+
+	// the vertices on the path from the root of the search to the current vertex
+	var path []K
+	onPath := make(map[K]bool)
+	var cycle []K
+
+	visitor := Visitor[K]{
+		Discover: func(key K, depth int) bool {
+			path = append(path, key)
+			onPath[key] = true
+			return true
+		},
+		Finish: func(key K, depth int) bool {
+			path = path[:len(path)-1]
+			onPath[key] = false
+
+			order[next] = key
+			next--
+			return true
+		},
+		Edge: func(src, dst K) bool {
+			// an edge back to a vertex on the path closes a cycle
+			if !onPath[dst] {
+				return true
+			}
+
+			for i := len(path) - 1; i >= 0; i-- {
+				if path[i] == dst {
+					cycle = append(cycle, path[i:]...)
+					break
+				}
+			}
+			return false
+		},
+	}
+
+	discovered := make(map[K]bool, len(g.vertices))
+	for _, v := range g.sortedVertices() {
+		if discovered[v.key] {
+			continue
+		}
+		if !g.dfs(v, discovered, visitor) {
+			return nil, &CycleError[K]{Cycle: cycle}
+		}
+	}
+
+	return order, nil
+}
+
+// Group the vertices of a directed graph into layers so that every edge goes
+// from an earlier layer to a later one. The vertices in a layer don't depend on
+// each other, so they can be processed concurrently once the layers before them are done.
+// The first layer holds the vertices without incoming edges and every other vertex
+// is in the layer after the last of its predecessors. Each layer keeps the order
+// its vertices were added to the graph in.
+// Returns a *CycleError if the graph has a cycle
+func (g *Graph[K, V, E]) TopologicalLayers() ([][]K, error) {
+	return g.kahn(true)
+}
+
+// Kahn's algorithm. Repeatedly removes the vertices without incoming edges,
+// either one at a time into a single layer or all at once into a new layer.
+// Vertices are removed in the order they were added to the graph whenever there is a choice.
+// Returns a *CycleError if some vertices are never removed
+func (g *Graph[K, V, E]) kahn(layered bool) ([][]K, error) {
+	if !g.directed {
+		return nil, errors.New("graph is undirected. Can't sort its vertices")
+	}
+
+	// number of edges each vertex has from vertices that haven't been removed yet
+	inDegrees := make(map[K]int, len(g.vertices))
+
+	// the vertices without incoming edges, the earliest added first
+	ready := Heap.NewHeapOf[*vertex[K, V, E], int]("min")
+
+	for _, v := range g.vertices {
+		inDegrees[v.key] = len(v.incoming)
+		if len(v.incoming) == 0 {
+			ready.Insert(v, v.index)
+		}
+	}
+
+	layers := [][]K{{}}
+	removed := 0
+
+	for ready.Size() > 0 {
+		var next []*vertex[K, V, E]
+
+		for ready.Size() > 0 {
+			current := ready.Remove().Value
+
+			layers[len(layers)-1] = append(layers[len(layers)-1], current.key)
+			removed++
+
+			for _, n := range current.neighbours {
+				inDegrees[n.vertex.key]--
+				if inDegrees[n.vertex.key] > 0 {
+					continue
+				}
+
+				// vertices freed by this layer go in the next one
+				if layered {
+					next = append(next, n.vertex)
+				} else {
+					ready.Insert(n.vertex, n.vertex.index)
+				}
+			}
+		}
+
+		if len(next) > 0 {
+			layers = append(layers, []K{})
+			for _, v := range next {
+				ready.Insert(v, v.index)
+			}
+		}
+	}
+
+	if removed < len(g.vertices) {
+		return nil, &CycleError[K]{Cycle: g.remainingCycle(inDegrees)}
+	}
+	if len(g.vertices) == 0 {
+		return [][]K{}, nil
+	}
+	return layers, nil
+}
+
+// Returns a cycle among the vertices Kahn's algorithm couldn't remove. Each of them
+// still has an edge from another one, so walking those edges backwards must repeat a vertex
+func (g *Graph[K, V, E]) remainingCycle(inDegrees map[K]int) []K {
+	var current *vertex[K, V, E]
+	for _, v := range g.sortedVertices() {
+		if inDegrees[v.key] > 0 {
+			current = v
+			break
+		}
+	}
+
+	position := make(map[K]int)
+	var walk []K
+	for {
+		if i, ok := position[current.key]; ok {
+			walk = walk[i:]
+			break
+		}
+		position[current.key] = len(walk)
+		walk = append(walk, current.key)
+
+		for _, n := range sortedNeighbours(current.incoming) {
+			if inDegrees[n.vertex.key] > 0 {
+				current = n.vertex
+				break
+			}
+		}
+	}
+
+	// the walk followed the edges backwards, reverse it into the order they are visited
+	for i, j := 0, len(walk)-1; i < j; i, j = i+1, j-1 {
+		walk[i], walk[j] = walk[j], walk[i]
+	}
+	return walk
+}
@@ -0,0 +1,194 @@
+package Graphs
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// Build steps and the steps they depend on
+var buildSteps = TestCase{
+	vertices: []string{"fetch", "configure", "codegen", "compile", "lint", "test", "package", "docs"},
+	edges: []edge{
+		{"fetch", "configure", 1},
+		{"configure", "codegen", 1},
+		{"configure", "compile", 1},
+		{"codegen", "compile", 1},
+		{"codegen", "lint", 1},
+		{"compile", "test", 1},
+		{"compile", "package", 1},
+		{"test", "package", 1},
+		{"docs", "package", 1},
+	},
+}
+
+// Checks that every edge of a test case goes from an earlier vertex to a later one
+func assertTopological(t *testing.T, testCase TestCase, order []string) {
+	t.Helper()
+
+	if len(order) != len(testCase.vertices) {
+		t.Fatalf("Expected %d vertices, got %v", len(testCase.vertices), order)
+	}
+
+	position := make(map[string]int)
+	for i, key := range order {
+		position[key] = i
+	}
+	for _, edge := range testCase.edges {
+		if position[edge.src] >= position[edge.dest] {
+			t.Errorf("Expected %s to come before %s, got %v", edge.src, edge.dest, order)
+		}
+	}
+}
+
+// Checks that a cycle error holds a cycle of the graph
+func assertCycle(t *testing.T, g *StringGraph, err error) {
+	t.Helper()
+
+	var cycleErr *CycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Expected a CycleError, got %v", err)
+	}
+
+	cycle := cycleErr.Cycle
+	if len(cycle) == 0 {
+		t.Fatal("Expected the cycle to have vertices, got none")
+	}
+	for i, key := range cycle {
+		next := cycle[(i+1)%len(cycle)]
+		if _, err := g.Edge(key, next); err != nil {
+			t.Errorf("Expected an edge %s - %s on the cycle %v", key, next, cycle)
+		}
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	t.Parallel()
+
+	algorithms := map[string]func(g *StringGraph) ([]string, error){
+		"Kahn": (*StringGraph).TopologicalSort,
+		"DFS":  (*StringGraph).TopologicalSortDFS,
+	}
+
+	for name, algorithm := range algorithms {
+		name, algorithm := name, algorithm
+
+		t.Run(name+" orders every edge forwards", func(t *testing.T) {
+			t.Parallel()
+
+			g := newTestGraph(NewDirectedGraph(), buildSteps)
+
+			order, err := algorithm(g)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			assertTopological(t, buildSteps, order)
+		})
+
+		t.Run(name+" with a cycle", func(t *testing.T) {
+			t.Parallel()
+
+			g := newTestGraph(NewDirectedGraph(), buildSteps)
+			g.AddEdge("test", "codegen", 1)
+
+			_, err := algorithm(g)
+			assertCycle(t, g, err)
+		})
+
+		t.Run(name+" with a self loop", func(t *testing.T) {
+			t.Parallel()
+
+			g := newTestGraph(NewDirectedGraph(), buildSteps)
+			g.AddEdge("lint", "lint", 1)
+
+			_, err := algorithm(g)
+			assertCycle(t, g, err)
+		})
+
+		t.Run(name+" with an empty graph", func(t *testing.T) {
+			t.Parallel()
+
+			order, err := algorithm(NewDirectedGraph())
+			if err != nil || len(order) != 0 {
+				t.Errorf("Expected an empty order, got %v with error %v", order, err)
+			}
+		})
+
+		t.Run(name+" with an undirected graph", func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := algorithm(newTestGraph(NewGraph(), eastAfrica)); err == nil {
+				t.Error("Expected an error for an undirected graph, got nil")
+			}
+		})
+	}
+
+	t.Run("Kahn keeps the order vertices were added in", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewDirectedGraph(), buildSteps)
+
+		order, _ := g.TopologicalSort()
+		expected := []string{"fetch", "configure", "codegen", "compile", "lint", "test", "docs", "package"}
+		if !reflect.DeepEqual(order, expected) {
+			t.Errorf("Expected %v, got %v", expected, order)
+		}
+
+		// c and d are both free once a and b are removed, and c was added first
+		g = newTestGraph(NewDirectedGraph(), TestCase{
+			vertices: []string{"a", "b", "c", "d"},
+			edges:    []edge{{"a", "d", 1}, {"b", "c", 1}},
+		})
+
+		order, _ = g.TopologicalSort()
+		if !reflect.DeepEqual(order, []string{"a", "b", "c", "d"}) {
+			t.Errorf("Expected %v, got %v", []string{"a", "b", "c", "d"}, order)
+		}
+	})
+}
+
+func TestTopologicalLayers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Groups independent vertices", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewDirectedGraph(), buildSteps)
+
+		layers, err := g.TopologicalLayers()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		expected := [][]string{
+			{"fetch", "docs"},
+			{"configure"},
+			{"codegen"},
+			{"compile", "lint"},
+			{"test"},
+			{"package"},
+		}
+		if !reflect.DeepEqual(layers, expected) {
+			t.Errorf("Expected %v, got %v", expected, layers)
+		}
+	})
+
+	t.Run("With a cycle", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewDirectedGraph(), buildSteps)
+		g.AddEdge("package", "fetch", 1)
+
+		_, err := g.TopologicalLayers()
+		assertCycle(t, g, err)
+	})
+
+	t.Run("With an empty graph", func(t *testing.T) {
+		t.Parallel()
+
+		layers, err := NewDirectedGraph().TopologicalLayers()
+		if err != nil || len(layers) != 0 {
+			t.Errorf("Expected no layers, got %v with error %v", layers, err)
+		}
+	})
+}
@@ -0,0 +1,152 @@
+package Graphs
+
+import "errors"
+
+// Hooks called while traversing a graph. Any of them may be nil.
+// Returning false from a hook stops the traversal
+type Visitor[K comparable] struct {
+	Discover func(key K, depth int) bool // a vertex is reached for the first time
+	Finish   func(key K, depth int) bool // every edge leaving a vertex has been examined
+	Edge     func(src, dst K) bool       // an edge is examined, before dst is discovered
+}
+
+// A vertex on the depth-first search stack and the index of the next edge to examine
+type dfsFrame[K comparable, V any, E any] struct {
+	vertex     *vertex[K, V, E]
+	neighbours []*neighbour[K, V, E]
+	next       int
+	depth      int
+}
+
+// Visit every vertex that can be reached from start in breadth-first order.
+// The depth of a vertex is the fewest edges it takes to reach it from start.
+// Edges are examined in the order their vertices were added to the graph,
+// and every undirected edge is examined from both of its ends
+func (g *Graph[K, V, E]) BFS(start K, visitor Visitor[K]) error {
+	from := g.GetVertex(start)
+	if from == nil {
+		return errors.New("start vertex does not exist")
+	}
+
+	depths := map[K]int{start: 0}
+	if !visitor.discover(start, 0) {
+		return nil
+	}
+
+	queue := []*vertex[K, V, E]{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		depth := depths[current.key]
+
+		for _, n := range sortedNeighbours(current.neighbours) {
+			if !visitor.edge(current.key, n.vertex.key) {
+				return nil
+			}
+			if _, ok := depths[n.vertex.key]; ok {
+				continue
+			}
+
+			depths[n.vertex.key] = depth + 1
+			if !visitor.discover(n.vertex.key, depth+1) {
+				return nil
+			}
+			queue = append(queue, n.vertex)
+		}
+
+		if !visitor.finish(current.key, depth) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// Visit every vertex that can be reached from start in depth-first order.
+// The depth of a vertex is the number of edges on the path the search took to it.
+// Edges are examined in the order their vertices were added to the graph,
+// and every undirected edge is examined from both of its ends
+func (g *Graph[K, V, E]) DFS(start K, visitor Visitor[K]) error {
+	from := g.GetVertex(start)
+	if from == nil {
+		return errors.New("start vertex does not exist")
+	}
+
+	g.dfs(from, make(map[K]bool), visitor)
+	return nil
+}
+
+// Depth-first search from a vertex that skips every vertex already in discovered,
+// which lets several searches share it. Returns false if a hook stopped the search
+func (g *Graph[K, V, E]) dfs(from *vertex[K, V, E], discovered map[K]bool, visitor Visitor[K]) bool {
+	discovered[from.key] = true
+	if !visitor.discover(from.key, 0) {
+		return false
+	}
+
+	// an explicit stack, so deep graphs can't overflow the call stack
+	stack := []*dfsFrame[K, V, E]{{vertex: from, neighbours: sortedNeighbours(from.neighbours)}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+
+		if current.next == len(current.neighbours) {
+			stack = stack[:len(stack)-1]
+			if !visitor.finish(current.vertex.key, current.depth) {
+				return false
+			}
+			continue
+		}
+
+		n := current.neighbours[current.next]
+		current.next++
+
+		if !visitor.edge(current.vertex.key, n.vertex.key) {
+			return false
+		}
+		if discovered[n.vertex.key] {
+			continue
+		}
+
+		discovered[n.vertex.key] = true
+		if !visitor.discover(n.vertex.key, current.depth+1) {
+			return false
+		}
+		stack = append(stack, &dfsFrame[K, V, E]{
+			vertex:     n.vertex,
+			neighbours: sortedNeighbours(n.vertex.neighbours),
+			depth:      current.depth + 1,
+		})
+	}
+
+	return true
+}
+
+// Returns an iterator over the keys and depths of the vertices that can be
+// reached from start, in breadth-first order. Iteration stops early when yield
+// returns false. Yields nothing if start does not exist
+func (g *Graph[K, V, E]) BreadthFirst(start K) func(yield func(K, int) bool) {
+	return func(yield func(K, int) bool) {
+		g.BFS(start, Visitor[K]{Discover: yield})
+	}
+}
+
+// Returns an iterator over the keys and depths of the vertices that can be
+// reached from start, in the order a depth-first search discovers them.
+// Iteration stops early when yield returns false. Yields nothing if start does not exist
+func (g *Graph[K, V, E]) DepthFirst(start K) func(yield func(K, int) bool) {
+	return func(yield func(K, int) bool) {
+		g.DFS(start, Visitor[K]{Discover: yield})
+	}
+}
+
+func (v Visitor[K]) discover(key K, depth int) bool {
+	return v.Discover == nil || v.Discover(key, depth)
+}
+
+func (v Visitor[K]) finish(key K, depth int) bool {
+	return v.Finish == nil || v.Finish(key, depth)
+}
+
+func (v Visitor[K]) edge(src, dst K) bool {
+	return v.Edge == nil || v.Edge(src, dst)
+}
@@ -0,0 +1,219 @@
+package Graphs
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// A directed graph with a cycle between b and d, and an unreachable vertex f
+//
+//	a -> b -> d -> b
+//	a -> c -> d -> e
+var traversalGraph = TestCase{
+	vertices: []string{"a", "b", "c", "d", "e", "f"},
+	edges: []edge{
+		{"a", "b", 1}, {"a", "c", 1},
+		{"b", "d", 1}, {"c", "d", 1},
+		{"d", "b", 1}, {"d", "e", 1},
+	},
+}
+
+// Records every hook a traversal calls
+func recordingVisitor(events *[]string) Visitor[string] {
+	return Visitor[string]{
+		Discover: func(key string, depth int) bool {
+			*events = append(*events, fmt.Sprintf("discover %s %d", key, depth))
+			return true
+		},
+		Finish: func(key string, depth int) bool {
+			*events = append(*events, fmt.Sprintf("finish %s %d", key, depth))
+			return true
+		},
+		Edge: func(src, dst string) bool {
+			*events = append(*events, fmt.Sprintf("edge %s-%s", src, dst))
+			return true
+		},
+	}
+}
+
+func TestBFS(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Visits vertices level by level", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewDirectedGraph(), traversalGraph)
+
+		var events []string
+		if err := g.BFS("a", recordingVisitor(&events)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		expected := []string{
+			"discover a 0",
+			"edge a-b", "discover b 1", "edge a-c", "discover c 1", "finish a 0",
+			"edge b-d", "discover d 2", "finish b 1",
+			"edge c-d", "finish c 1",
+			"edge d-b", "edge d-e", "discover e 3", "finish d 2",
+			"finish e 3",
+		}
+		if !reflect.DeepEqual(events, expected) {
+			t.Errorf("Expected events to be %v, got %v", expected, events)
+		}
+	})
+
+	t.Run("Stops early", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewDirectedGraph(), traversalGraph)
+
+		var discovered []string
+		g.BFS("a", Visitor[string]{
+			Discover: func(key string, depth int) bool {
+				discovered = append(discovered, key)
+				return key != "c"
+			},
+		})
+
+		if !reflect.DeepEqual(discovered, []string{"a", "b", "c"}) {
+			t.Errorf("Expected to stop after discovering c, got %v", discovered)
+		}
+	})
+
+	t.Run("Iterator yields keys and depths", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewGraph(), eastAfrica)
+
+		depths := make(map[string]int)
+		g.BreadthFirst("nairobi")(func(key string, depth int) bool {
+			depths[key] = depth
+			return true
+		})
+
+		if len(depths) != len(eastAfrica.vertices) {
+			t.Errorf("Expected %d vertices, got %d", len(eastAfrica.vertices), len(depths))
+		}
+		if depths["nairobi"] != 0 || depths["kisumu"] != 1 || depths["jinja"] != 2 || depths["kampala"] != 3 {
+			t.Errorf("Expected depths of nairobi, kisumu, jinja and kampala to be 0, 1, 2 and 3, got %v", depths)
+		}
+	})
+
+	t.Run("Missing start vertex", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewDirectedGraph(), traversalGraph)
+
+		if err := g.BFS("z", Visitor[string]{}); err == nil {
+			t.Error("Expected an error for a missing start vertex, got nil")
+		}
+
+		g.BreadthFirst("z")(func(key string, depth int) bool {
+			t.Errorf("Expected nothing to be yielded, got %s", key)
+			return true
+		})
+	})
+}
+
+func TestDFS(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Visits vertices branch by branch", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewDirectedGraph(), traversalGraph)
+
+		var events []string
+		if err := g.DFS("a", recordingVisitor(&events)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		expected := []string{
+			"discover a 0",
+			"edge a-b", "discover b 1",
+			"edge b-d", "discover d 2",
+			"edge d-b",
+			"edge d-e", "discover e 3", "finish e 3",
+			"finish d 2",
+			"finish b 1",
+			"edge a-c", "discover c 1",
+			"edge c-d",
+			"finish c 1",
+			"finish a 0",
+		}
+		if !reflect.DeepEqual(events, expected) {
+			t.Errorf("Expected events to be %v, got %v", expected, events)
+		}
+	})
+
+	t.Run("Stops early", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewDirectedGraph(), traversalGraph)
+
+		var finished []string
+		g.DFS("a", Visitor[string]{
+			Finish: func(key string, depth int) bool {
+				finished = append(finished, key)
+				return true
+			},
+			Edge: func(src, dst string) bool {
+				return dst != "e"
+			},
+		})
+
+		if len(finished) != 0 {
+			t.Errorf("Expected to stop before finishing any vertex, got %v", finished)
+		}
+	})
+
+	t.Run("Iterator yields keys and depths", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewDirectedGraph(), traversalGraph)
+
+		var visited []string
+		g.DepthFirst("a")(func(key string, depth int) bool {
+			visited = append(visited, fmt.Sprintf("%s %d", key, depth))
+			return key != "e"
+		})
+
+		expected := []string{"a 0", "b 1", "d 2", "e 3"}
+		if !reflect.DeepEqual(visited, expected) {
+			t.Errorf("Expected %v, got %v", expected, visited)
+		}
+	})
+
+	t.Run("Deep graphs", func(t *testing.T) {
+		t.Parallel()
+
+		g := NewGraphOf[int, any, float64](true, nil)
+		for i := 0; i < 100000; i++ {
+			g.AddVertex(i)
+			if i > 0 {
+				g.AddEdge(i-1, i, 1)
+			}
+		}
+
+		deepest := 0
+		g.DepthFirst(0)(func(key int, depth int) bool {
+			deepest = depth
+			return true
+		})
+
+		if deepest != 99999 {
+			t.Errorf("Expected the deepest vertex to have depth 99999, got %d", deepest)
+		}
+	})
+
+	t.Run("Missing start vertex", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestGraph(NewDirectedGraph(), traversalGraph)
+
+		if err := g.DFS("z", Visitor[string]{}); err == nil {
+			t.Error("Expected an error for a missing start vertex, got nil")
+		}
+	})
+}
@@ -2,18 +2,16 @@ package HashMap
 
 import (
 	"errors"
-	"hash/fnv"
-	"reflect"
-	"strconv"
 	"sync"
+
+	"github.com/AustinMusiku/dataStructures/Hashing"
 )
 
-type Hashable interface {
-	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr | ~float32 | ~float64 | ~string
-}
+type Hashable = Hashing.Hashable
 
 type HashMap[K Hashable, V any] struct {
 	mu            sync.Mutex
+	hasher        Hashing.Hasher[K]
 	buckets       []*bucket[K, V]
 	size          int
 	initSize      int
@@ -24,6 +22,7 @@ type HashMap[K Hashable, V any] struct {
 // initialize new HashMap
 func NewHashMap[K Hashable, V any](size int, maxLoadFactor float64) *HashMap[K, V] {
 	hashmap := new(HashMap[K, V])
+	hashmap.hasher = Hashing.NewHasher[K](0)
 	hashmap.size = size
 	hashmap.initSize = size
 	hashmap.maxLoadFactor = maxLoadFactor
@@ -124,34 +123,7 @@ func (h *HashMap[K, V]) Resize(size int) error {
 
 // Hash function.
 func (h *HashMap[H, T]) hash(key H) uint {
-	hashFunc := fnv.New64a()
-
-	var strValue string
-
-	switch reflect.TypeOf(key).Kind() {
-	// integers
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		iValue := reflect.ValueOf(key).Int()
-		strValue = strconv.FormatInt(iValue, 10)
-
-	// unsigned integers
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		uValue := reflect.ValueOf(key).Uint()
-		strValue = strconv.FormatUint(uValue, 10)
-
-	// floats
-	case reflect.Float32, reflect.Float64:
-		floatValue := reflect.ValueOf(key).Float()
-		strValue = strconv.FormatFloat(floatValue, 'f', -1, 64)
-
-	// strings
-	case reflect.String:
-		strValue = reflect.ValueOf(key).String()
-	}
-
-	hashFunc.Write([]byte(strValue))
-
-	return uint(hashFunc.Sum64()) % uint(h.size)
+	return uint(h.hasher.Sum64(key) % uint64(h.size))
 }
 
 // Get size of hashmap
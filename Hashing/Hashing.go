@@ -0,0 +1,146 @@
+package Hashing
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"reflect"
+	"unsafe"
+)
+
+type Hashable interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr | ~float32 | ~float64 | ~string
+}
+
+// how the bytes of a value are read
+type kind uint8
+
+const (
+	kindInteger kind = iota
+	kindFloat32
+	kindFloat64
+	kindString
+)
+
+const (
+	fnvOffset64 = 14695981039346656037
+	golden64    = 0x9e3779b97f4a7c15
+
+	// multipliers from MurmurHash3's block mixing
+	murmurC1 = 0x87c37b91114253d5
+	murmurC2 = 0x4cf5ad432745937f
+)
+
+// Hasher hashes values of T straight from their memory.
+// The kind of T is resolved once when the hasher is created, so hashing
+// a value needs neither reflection nor allocations
+type Hasher[T Hashable] struct {
+	kind kind
+	size uintptr
+	seed uint64
+}
+
+// Create a new Hasher. Hashers with different seeds produce unrelated hashes
+func NewHasher[T Hashable](seed uint64) Hasher[T] {
+	var zero T
+
+	h := Hasher[T]{
+		kind: kindInteger,
+		size: unsafe.Sizeof(zero),
+		seed: seed,
+	}
+
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.Float32:
+		h.kind = kindFloat32
+	case reflect.Float64:
+		h.kind = kindFloat64
+	case reflect.String:
+		h.kind = kindString
+	}
+
+	return h
+}
+
+// Get the seed of the hasher
+func (h Hasher[T]) Seed() uint64 {
+	return h.seed
+}
+
+// Returns the 64-bit hash of a value.
+// Values that compare equal always have the same hash
+func (h Hasher[T]) Sum64(value T) uint64 {
+	p := unsafe.Pointer(&value)
+
+	switch h.kind {
+	case kindString:
+		return h.sumString(*(*string)(p))
+
+	case kindFloat32:
+		f := *(*float32)(p)
+		// -0 and +0 are equal but have different bits
+		if f == 0 {
+			return h.sumUint64(0)
+		}
+		return h.sumUint64(uint64(math.Float32bits(f)))
+
+	case kindFloat64:
+		f := *(*float64)(p)
+		if f == 0 {
+			return h.sumUint64(0)
+		}
+		return h.sumUint64(math.Float64bits(f))
+	}
+
+	switch h.size {
+	case 1:
+		return h.sumUint64(uint64(*(*uint8)(p)))
+	case 2:
+		return h.sumUint64(uint64(*(*uint16)(p)))
+	case 4:
+		return h.sumUint64(uint64(*(*uint32)(p)))
+	default:
+		return h.sumUint64(*(*uint64)(p))
+	}
+}
+
+// Hash fixed-size values with a single round of the finalizer
+func (h Hasher[T]) sumUint64(x uint64) uint64 {
+	return Mix(x ^ (h.seed + golden64))
+}
+
+// Hash strings 8 bytes at a time, folding the last few bytes into one more word.
+// The length is mixed in at the end so strings that only differ by trailing
+// zero bytes have different hashes
+func (h Hasher[T]) sumString(s string) uint64 {
+	// view the string's bytes without copying them
+	b := unsafe.Slice(*(**byte)(unsafe.Pointer(&s)), len(s))
+
+	sum := uint64(fnvOffset64) ^ h.seed
+	for ; len(b) >= 8; b = b[8:] {
+		sum = mixWord(sum, binary.LittleEndian.Uint64(b))
+	}
+
+	var tail uint64
+	for i := len(b) - 1; i >= 0; i-- {
+		tail = tail<<8 | uint64(b[i])
+	}
+	sum = mixWord(sum, tail)
+
+	return Mix(sum ^ uint64(len(s)))
+}
+
+// Mixes a word of a string into the running hash
+func mixWord(sum, word uint64) uint64 {
+	return bits.RotateLeft64(sum^word*murmurC1, 31) * murmurC2
+}
+
+// Finalizer from MurmurHash3. Spreads every input bit across the whole output
+func Mix(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
@@ -0,0 +1,192 @@
+package Hashing
+
+import (
+	"hash/fnv"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+type userID int32
+type label string
+
+func TestHasher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Equal values have equal hashes", func(t *testing.T) {
+		t.Parallel()
+
+		ints := NewHasher[int](0)
+		if ints.Sum64(42) != ints.Sum64(42) {
+			t.Error("Expected equal ints to have equal hashes")
+		}
+
+		strs := NewHasher[string](0)
+		a, b := "daryl", "dar"
+		b += "yl" // build b at runtime so it doesn't share a's memory
+		if strs.Sum64(a) != strs.Sum64(b) {
+			t.Error("Expected equal strings to have equal hashes")
+		}
+
+		floats := NewHasher[float64](0)
+		if floats.Sum64(0) != floats.Sum64(math.Copysign(0, -1)) {
+			t.Error("Expected +0 and -0 to have equal hashes")
+		}
+	})
+
+	t.Run("Different values have different hashes", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name string
+			a, b uint64
+		}{
+			{"int", NewHasher[int](0).Sum64(1), NewHasher[int](0).Sum64(2)},
+			{"int8", NewHasher[int8](0).Sum64(-1), NewHasher[int8](0).Sum64(1)},
+			{"float32", NewHasher[float32](0).Sum64(1.5), NewHasher[float32](0).Sum64(2.5)},
+			{"string", NewHasher[string](0).Sum64("rick"), NewHasher[string](0).Sum64("carl")},
+			{"long string", NewHasher[string](0).Sum64("alexandria safe-zone"), NewHasher[string](0).Sum64("alexandria safe-zonf")},
+			{"trailing zero", NewHasher[string](0).Sum64("a"), NewHasher[string](0).Sum64("a\x00")},
+			{"named int", NewHasher[userID](0).Sum64(7), NewHasher[userID](0).Sum64(8)},
+			{"named string", NewHasher[label](0).Sum64("a"), NewHasher[label](0).Sum64("b")},
+		}
+
+		for _, test := range tests {
+			if test.a == test.b {
+				t.Errorf("Expected different %s values to have different hashes, got %d", test.name, test.a)
+			}
+		}
+	})
+
+	t.Run("Named types hash like their underlying type", func(t *testing.T) {
+		t.Parallel()
+
+		if NewHasher[userID](0).Sum64(7) != NewHasher[int32](0).Sum64(7) {
+			t.Error("Expected userID(7) and int32(7) to have equal hashes")
+		}
+		if NewHasher[label](0).Sum64("a") != NewHasher[string](0).Sum64("a") {
+			t.Error("Expected label(\"a\") and \"a\" to have equal hashes")
+		}
+	})
+
+	t.Run("Seeds", func(t *testing.T) {
+		t.Parallel()
+
+		if NewHasher[string](1).Sum64("rick") == NewHasher[string](2).Sum64("rick") {
+			t.Error("Expected different seeds to produce different hashes")
+		}
+		if NewHasher[int](1).Sum64(5) == NewHasher[int](2).Sum64(5) {
+			t.Error("Expected different seeds to produce different hashes")
+		}
+	})
+
+}
+
+func TestHasherAllocations(t *testing.T) {
+	ints, floats, strs := NewHasher[int](0), NewHasher[float64](0), NewHasher[string](0)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		sink = ints.Sum64(12345)
+		sink = floats.Sum64(3.14)
+		sink = strs.Sum64("sebastian milton")
+	})
+
+	if allocs != 0 {
+		t.Errorf("Expected 0 allocations, got %f", allocs)
+	}
+}
+
+// The reflect and strconv based hash that BloomFilter and HashMap used to use
+func legacySum64[T Hashable](value T) uint64 {
+	hashFunc := fnv.New64a()
+
+	var data string
+
+	switch reflect.TypeOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		data = strconv.FormatInt(reflect.ValueOf(value).Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		data = strconv.FormatUint(reflect.ValueOf(value).Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		data = strconv.FormatFloat(reflect.ValueOf(value).Float(), 'f', -1, 64)
+	case reflect.String:
+		data = reflect.ValueOf(value).String()
+	}
+
+	hashFunc.Write([]byte(data))
+	return hashFunc.Sum64()
+}
+
+var sink uint64
+
+// Keys that vary between iterations, so the benchmarks measure hashing real
+// keys rather than a constant the compiler can see through
+func benchmarkKeys(length int) []string {
+	keys := make([]string, 1024)
+	for i := range keys {
+		key := "user-" + strconv.Itoa(i) + "@"
+		for len(key) < length {
+			key += "example.com/"
+		}
+		keys[i] = key[:length]
+	}
+	return keys
+}
+
+func BenchmarkHasher(b *testing.B) {
+	b.Run("int", func(b *testing.B) {
+		h := NewHasher[int](0)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sink = h.Sum64(i)
+		}
+	})
+
+	b.Run("float64", func(b *testing.B) {
+		h := NewHasher[float64](0)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sink = h.Sum64(float64(i) * 1.5)
+		}
+	})
+
+	for _, length := range []int{16, 64} {
+		keys := benchmarkKeys(length)
+
+		b.Run("string/"+strconv.Itoa(length), func(b *testing.B) {
+			h := NewHasher[string](0)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sink = h.Sum64(keys[i%len(keys)])
+			}
+		})
+	}
+}
+
+func BenchmarkLegacy(b *testing.B) {
+	b.Run("int", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sink = legacySum64(i)
+		}
+	})
+
+	b.Run("float64", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sink = legacySum64(float64(i) * 1.5)
+		}
+	})
+
+	for _, length := range []int{16, 64} {
+		keys := benchmarkKeys(length)
+
+		b.Run("string/"+strconv.Itoa(length), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sink = legacySum64(keys[i%len(keys)])
+			}
+		})
+	}
+}
@@ -1,37 +1,69 @@
 package Heap
 
-import "math"
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
 
 type Sortable[T any] struct {
 	Value    T
 	Priority int
 }
 
+// An item in a HeapOf, with a priority of any ordered type
+type SortableOf[T any, P constraints.Ordered] struct {
+	Value    T
+	Priority P
+}
+
+// A heap with integer priorities
 type Heap[T any] struct {
-	items []Sortable[T]
+	*HeapOf[T, int]
+}
+
+// A heap with priorities of any ordered type, such as float64 or string
+type HeapOf[T any, P constraints.Ordered] struct {
+	items []SortableOf[T, P]
 	size  int
 	mode  string
 }
 
 // Create a new heap
 func NewHeap[T any](mode string) *Heap[T] {
-	return &Heap[T]{
-		items: make([]Sortable[T], 0),
+	return &Heap[T]{NewHeapOf[T, int](mode)}
+}
+
+// Create a new heap with priorities of type P
+func NewHeapOf[T any, P constraints.Ordered](mode string) *HeapOf[T, P] {
+	return &HeapOf[T, P]{
+		items: make([]SortableOf[T, P], 0),
 		size:  0,
 		mode:  mode,
 	}
 }
 
+// Remove item from the heap.
+// Returns the item with the highest priority
+func (h *Heap[T]) Remove() Sortable[T] {
+	return Sortable[T](h.HeapOf.Remove())
+}
+
+// Peek at the top item in the heap
+func (h *Heap[T]) Peek() Sortable[T] {
+	return Sortable[T](h.HeapOf.Peek())
+}
+
 // Add item to the heap
-func (h *Heap[T]) Insert(value T, priority int) {
-	item := Sortable[T]{value, priority}
+func (h *HeapOf[T, P]) Insert(value T, priority P) {
+	item := SortableOf[T, P]{value, priority}
 
 	// if no removals have been made, append to the end of the array
 	// otherwise, insert at the end of the heap and shift the removed items 1 index to the right
 	if h.size == len(h.items) {
 		h.items = append(h.items, item)
 	} else {
-		newItems := make([]Sortable[T], len(h.items)+1)
+		newItems := make([]SortableOf[T, P], len(h.items)+1)
 		removed := h.items[h.size:]
 		copy(newItems, h.items[:h.size])
 		newItems[h.size] = item
@@ -47,7 +79,7 @@ func (h *Heap[T]) Insert(value T, priority int) {
 
 // Remove item from the heap.
 // Returns the item with the highest priority
-func (h *Heap[T]) Remove() Sortable[T] {
+func (h *HeapOf[T, P]) Remove() SortableOf[T, P] {
 	current := h.items[0]
 
 	h.swap(0, h.size-1)
@@ -57,23 +89,23 @@ func (h *Heap[T]) Remove() Sortable[T] {
 }
 
 // Peek at the top item in the heap
-func (h *Heap[T]) Peek() Sortable[T] {
+func (h *HeapOf[T, P]) Peek() SortableOf[T, P] {
 	return h.items[0]
 }
 
 // Get the size of the heap
-func (h *Heap[T]) Size() int {
+func (h *HeapOf[T, P]) Size() int {
 	return h.size
 }
 
 // swap the values of two items in the heap
-func (h *Heap[T]) swap(index1, index2 int) {
+func (h *HeapOf[T, P]) swap(index1, index2 int) {
 	h.items[index1], h.items[index2] = h.items[index2], h.items[index1]
 }
 
 // This method is called after a push to the heap.
 // Moves the last inserted item up the heap to its correct position
-func (h *Heap[T]) heapifyUp() {
+func (h *HeapOf[T, P]) heapifyUp() {
 	inserted := h.size - 1 // the index of the last inserted item (the last item in the heap)
 	parent := getParentIndex(inserted)
 
@@ -90,7 +122,7 @@ func (h *Heap[T]) heapifyUp() {
 
 // This method is called after a poll from the heap.
 // Moves the item at the top down the heap to its correct position
-func (h *Heap[T]) heapifyDown() {
+func (h *HeapOf[T, P]) heapifyDown() {
 	current := 0 // index of the first item in the heap
 	child := -1
 
@@ -118,7 +150,7 @@ func (h *Heap[T]) heapifyDown() {
 // -------------------------------------------------------------------------------
 
 // Returns the truthy value based on the mode of the heap
-func (h *Heap[T]) evaluateMode(x, y int) bool {
+func (h *HeapOf[T, P]) evaluateMode(x, y P) bool {
 	if h.mode == "max" {
 		return x > y
 	} else {
@@ -127,19 +159,19 @@ func (h *Heap[T]) evaluateMode(x, y int) bool {
 }
 
 // Returns true if the node has a parent
-func (h *Heap[T]) hasParent(index int) bool {
+func (h *HeapOf[T, P]) hasParent(index int) bool {
 	parentIndex := math.Floor((float64(index) - 1) / 2)
 	return parentIndex >= 0
 }
 
 // Returns true if the node has a left child
-func (h *Heap[T]) hasLeft(index int) bool {
+func (h *HeapOf[T, P]) hasLeft(index int) bool {
 	leftIndex := index*2 + 1
 	return leftIndex < h.size
 }
 
 // Returns true if the node has a right child
-func (h *Heap[T]) hasRight(index int) bool {
+func (h *HeapOf[T, P]) hasRight(index int) bool {
 	leftIndex := index*2 + 2
 	return leftIndex < h.size
 }
@@ -160,7 +192,7 @@ func getRightIndex(index int) int {
 }
 
 // Returns the value of the left child
-func (h *Heap[T]) getLeft(index int) *Sortable[T] {
+func (h *HeapOf[T, P]) getLeft(index int) *SortableOf[T, P] {
 	if h.hasLeft(index) {
 		return &h.items[getLeftIndex(index)]
 	}
@@ -168,7 +200,7 @@ func (h *Heap[T]) getLeft(index int) *Sortable[T] {
 }
 
 // Returns the value of the right child
-func (h *Heap[T]) getRight(index int) *Sortable[T] {
+func (h *HeapOf[T, P]) getRight(index int) *SortableOf[T, P] {
 	if h.hasRight(index) {
 		return &h.items[getRightIndex(index)]
 	}